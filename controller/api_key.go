@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
+)
+
+func (controller *Controller) setApiKeyRouter() {
+	// authorization
+	// API 키 관리는 로그인한 회원만 가능 (API 키로 API 키 발급 불가)
+	apiKeyRoutes := controller.router.Group("/api/auth/api-keys").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil))
+
+	// API 키 발급 api
+	apiKeyRoutes.POST("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.CreateApiKeyRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.CreateApiKeyParams{
+			UserID:                  authPayload.UserID,
+			CreateApiKeyRequestBody: reqBody,
+		}
+
+		// API 키 발급
+		result, cErr := controller.service.CreateApiKey(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// API 키 목록 조회 api
+	apiKeyRoutes.GET("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		params := service.GetApiKeyListParams{
+			UserID: authPayload.UserID,
+		}
+
+		// API 키 목록 조회
+		result, cErr := controller.service.GetApiKeyList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// API 키 폐기 api
+	apiKeyRoutes.DELETE("/:id", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqPath dto.RevokeApiKeyRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+
+		params := service.RevokeApiKeyParams{
+			UserID:                  authPayload.UserID,
+			RevokeApiKeyRequestPath: reqPath,
+		}
+
+		// API 키 폐기
+		cErr := controller.service.RevokeApiKey(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+}
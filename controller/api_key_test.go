@@ -0,0 +1,482 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	mockservice "github.com/gitaepark/pha/service/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/validator"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	apiKeyID := util.CreateRandomInt64(1, 10)
+	name := util.CreateRandomString(10)
+	scopes := []string{dto.ApiKeyScopeProductsRead}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"name":            name,
+				"scopes":          scopes,
+				"expires_in_days": 30,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Eq(service.CreateApiKeyParams{
+						UserID: userID,
+						CreateApiKeyRequestBody: dto.CreateApiKeyRequestBody{
+							Name:          name,
+							Scopes:        scopes,
+							ExpiresInDays: 30,
+						},
+					})).
+					Times(1).
+					Return(dto.CreateApiKeyResponse{ID: apiKeyID, Scopes: scopes}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Equal(t, responseBody.Data.(map[string]interface{})["id"], float64(apiKeyID))
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			body: gin.H{
+				"name":   name,
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "API 키 인증",
+			body: gin.H{
+				"name":   name,
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddApiKeyAuthorization(t, request)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "이름 미입력",
+			body: gin.H{
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("name")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "권한 범위 미입력",
+			body: gin.H{
+				"name":   name,
+				"scopes": []string{},
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "지원하지 않는 권한 범위",
+			body: gin.H{
+				"name":   name,
+				"scopes": []string{"users:write"},
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrOneOf("scopes", "products:read products:write")).Err.Error())
+			},
+		},
+		{
+			name: "최대 만료 기간 초과",
+			body: gin.H{
+				"name":            name,
+				"scopes":          scopes,
+				"expires_in_days": 366,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrMax("expires_in_days", "365")).Err.Error())
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"name":   name,
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.CreateApiKeyResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/api-keys/"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestGetApiKeyList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetApiKeyList(gomock.Any(), gomock.Eq(service.GetApiKeyListParams{UserID: userID})).
+					Times(1).
+					Return(dto.GetApiKeyListResponse{
+						List: []dto.GetApiKeyResponse{{
+							ID:     util.CreateRandomInt64(1, 10),
+							Name:   util.CreateRandomString(10),
+							Scopes: []string{dto.ApiKeyScopeProductsRead},
+						}},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetApiKeyList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					GetApiKeyList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetApiKeyListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/api-keys/"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	apiKeyID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		uri           string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  fmt.Sprint(apiKeyID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Eq(service.RevokeApiKeyParams{
+						UserID:                  userID,
+						RevokeApiKeyRequestPath: dto.RevokeApiKeyRequestPath{ID: apiKeyID},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			uri:  fmt.Sprint(apiKeyID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "최소값 미만의 id",
+			uri:  "0",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  fmt.Sprint(apiKeyID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/api-keys/" + tc.uri
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
@@ -1,15 +1,40 @@
 package controller
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/gitaepark/pha/controller/response"
 	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
 	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
 )
 
 func (controller *Controller) setAuthRouter() {
 	authRouter := controller.router.Group("/api/auth")
 
+	// 인증번호 발송 api
+	authRouter.POST("/verification", func(ctx *gin.Context) {
+		var reqBody dto.SendVerificationCodeRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.SendVerificationCodeParams(reqBody)
+
+		// 인증번호 발송
+		cErr := controller.service.SendVerificationCode(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
 	// 회원가입 api
 	authRouter.POST("/register", func(ctx *gin.Context) {
 		var reqBody dto.RegisterRequestBody
@@ -19,7 +44,11 @@ func (controller *Controller) setAuthRouter() {
 			return
 		}
 
-		params := service.RegisterParams(reqBody)
+		params := service.RegisterParams{
+			RegisterRequestBody: reqBody,
+			UserAgent:           ctx.Request.UserAgent(),
+			ClientIp:            ctx.ClientIP(),
+		}
 
 		// 회원가입
 		cErr := controller.service.Register(ctx, params)
@@ -56,6 +85,31 @@ func (controller *Controller) setAuthRouter() {
 		response.NewOkResponse(ctx, result)
 	})
 
+	// 2단계 인증 로그인 api
+	authRouter.POST("/login/2fa", func(ctx *gin.Context) {
+		var reqBody dto.LoginTwoFactorRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.LoginTwoFactorParams{
+			LoginTwoFactorRequestBody: reqBody,
+			UserAgent:                 ctx.Request.UserAgent(),
+			ClientIp:                  ctx.ClientIP(),
+		}
+
+		// 2단계 인증 로그인
+		result, cErr := controller.service.LoginTwoFactor(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
 	// access 토큰 재발급 api
 	authRouter.POST("/token", func(ctx *gin.Context) {
 		var reqBody dto.RenewAccessTokenRequestBody
@@ -80,4 +134,157 @@ func (controller *Controller) setAuthRouter() {
 
 		response.NewOkResponse(ctx, result)
 	})
+
+	// 로그아웃 api
+	authRouter.POST("/logout", func(ctx *gin.Context) {
+		var reqBody dto.LogoutRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.LogoutParams{
+			LogoutRequestBody: reqBody,
+			UserAgent:         ctx.Request.UserAgent(),
+			ClientIp:          ctx.ClientIP(),
+		}
+
+		// 로그아웃
+		cErr := controller.service.Logout(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 비밀번호 변경 api
+	authRouter.PATCH("/password", middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil), func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.ChangePasswordRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.ChangePasswordParams{
+			UserID:                    authPayload.UserID,
+			SessionID:                 authPayload.SessionID,
+			ChangePasswordRequestBody: reqBody,
+			UserAgent:                 ctx.Request.UserAgent(),
+			ClientIp:                  ctx.ClientIP(),
+		}
+
+		// 비밀번호 변경
+		cErr := controller.service.ChangePassword(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 비밀번호 재설정 api
+	authRouter.POST("/password/reset", func(ctx *gin.Context) {
+		var reqBody dto.ResetPasswordRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.ResetPasswordParams{
+			ResetPasswordRequestBody: reqBody,
+			UserAgent:                ctx.Request.UserAgent(),
+			ClientIp:                 ctx.ClientIP(),
+		}
+
+		// 비밀번호 재설정
+		cErr := controller.service.ResetPassword(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 2단계 인증 등록 api
+	authRouter.POST("/2fa", middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil), func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.EnrollTwoFactorRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.EnrollTwoFactorParams{
+			UserID:                     authPayload.UserID,
+			EnrollTwoFactorRequestBody: reqBody,
+		}
+
+		// 2단계 인증 등록
+		result, cErr := controller.service.EnrollTwoFactor(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 2단계 인증 등록 확인 api
+	authRouter.POST("/2fa/confirm", middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil), func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.ConfirmTwoFactorRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.ConfirmTwoFactorParams{
+			UserID:                      authPayload.UserID,
+			ConfirmTwoFactorRequestBody: reqBody,
+		}
+
+		// 2단계 인증 등록 확인
+		cErr := controller.service.ConfirmTwoFactor(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 토큰 검사 api (내부 서비스용)
+	authRouter.POST("/introspect", middleware.ClientAuthMiddleware(controller.config.IntrospectionClientID, controller.config.IntrospectionClientSecret), func(ctx *gin.Context) {
+		var reqBody dto.IntrospectTokenRequestBody
+		// req body dto 검증 (RFC 7662 form, json 모두 허용)
+		if err := ctx.ShouldBind(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "form")
+			return
+		}
+
+		params := service.IntrospectTokenParams(reqBody)
+
+		// 토큰 검사
+		result, cErr := controller.service.IntrospectToken(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		// RFC 7662 형식으로 응답
+		ctx.JSON(http.StatusOK, result)
+	})
 }
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
+)
+
+func (controller *Controller) setAuthEventRouter() {
+	// authorization
+	authEventRoutes := controller.router.Group("/api/auth/events").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil))
+
+	// 인증 이벤트 목록 조회 api
+	authEventRoutes.GET("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqQuery dto.GetAuthEventListRequestQuery
+		// req query dto 검증
+		if err := ctx.ShouldBindQuery(&reqQuery); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqQuery, "form")
+			return
+		}
+
+		params := service.GetAuthEventListParams{
+			UserID:                       authPayload.UserID,
+			GetAuthEventListRequestQuery: reqQuery,
+		}
+
+		// 인증 이벤트 목록 조회
+		result, cErr := controller.service.GetAuthEventList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+}
@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	mockservice "github.com/gitaepark/pha/service/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/validator"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthEventList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	page := util.CreateRandomInt32(1, 5)
+
+	testCases := []struct {
+		name          string
+		uri           string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  "?page=" + fmt.Sprint(page),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				params := service.GetAuthEventListParams{
+					UserID:                       userID,
+					GetAuthEventListRequestQuery: dto.GetAuthEventListRequestQuery{Page: page},
+				}
+
+				mockService.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(dto.GetAuthEventListResponse{
+						List: []dto.GetAuthEventResponse{{
+							ID:      util.CreateRandomInt64(1, 10),
+							Type:    "login",
+							Outcome: "success",
+						}},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			uri:  "?page=" + fmt.Sprint(page),
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "page 미입력",
+			uri:  "",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("page")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "page 타입 에러",
+			uri:  "?page=" + util.CreateRandomString(5),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(response.ErrParseString).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  "?page=" + fmt.Sprint(page),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetAuthEventListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/events/" + tc.uri
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
@@ -2,23 +2,28 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/corpix/uarand"
 	"github.com/gin-gonic/gin"
 	"github.com/gitaepark/pha/controller/response"
 	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
 	"github.com/gitaepark/pha/service"
 	mockservice "github.com/gitaepark/pha/service/mock"
 	"github.com/gitaepark/pha/util"
-	"github.com/gitaepark/pha/util/jwt"
 	"github.com/gitaepark/pha/util/validator"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -47,8 +52,9 @@ func TestResiger(t *testing.T) {
 		{
 			name: "성공",
 			body: gin.H{
-				"phone_number": util.CreateRandomPhoneNumber(),
-				"password":     util.CreateRandomString(10),
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -71,7 +77,8 @@ func TestResiger(t *testing.T) {
 		{
 			name: "휴대폰번호 미입력",
 			body: gin.H{
-				"password": util.CreateRandomString(10),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -91,8 +98,9 @@ func TestResiger(t *testing.T) {
 		{
 			name: "string 타입이 아닌 휴대폰번호 입력",
 			body: gin.H{
-				"phone_number": util.CreateRandomInt32(1, 100),
-				"password":     util.CreateRandomString(10),
+				"phone_number":      util.CreateRandomInt32(1, 100),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -112,8 +120,9 @@ func TestResiger(t *testing.T) {
 		{
 			name: "01000000000 양식이 아닌 휴대폰번호 입력",
 			body: gin.H{
-				"phone_number": util.CreateRandomString(10),
-				"password":     util.CreateRandomString(10),
+				"phone_number":      util.CreateRandomString(10),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -133,7 +142,8 @@ func TestResiger(t *testing.T) {
 		{
 			name: "비밀번호 미입력",
 			body: gin.H{
-				"phone_number": util.CreateRandomPhoneNumber(),
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -153,8 +163,9 @@ func TestResiger(t *testing.T) {
 		{
 			name: "string 타입이 아닌 비밀번호 타입 입력",
 			body: gin.H{
-				"phone_number": util.CreateRandomPhoneNumber(),
-				"password":     util.CreateRandomInt32(1, 10),
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"password":          util.CreateRandomInt32(1, 10),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -171,11 +182,78 @@ func TestResiger(t *testing.T) {
 				require.Nil(t, responseBody.Data)
 			},
 		},
+		{
+			name: "비밀번호 정책에 맞지 않는 비밀번호 입력",
+			body: gin.H{
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"password":          util.CreateRandomString(10),
+				"verification_code": "123456",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrPasswordCharClasses("password", fmt.Sprint(testConfig.PasswordMinCharClasses))).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "휴대폰번호가 포함된 비밀번호 입력",
+			body: gin.H{
+				"phone_number":      "01012345678",
+				"password":          "Pw01012345678",
+				"verification_code": "123456",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrPasswordPhoneNumber("password")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "6자리 숫자가 아닌 인증번호 입력",
+			body: gin.H{
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "12345a",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrVerificationCode("verification_code")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "Internal Service Error",
 			body: gin.H{
-				"phone_number": util.CreateRandomPhoneNumber(),
-				"password":     util.CreateRandomString(10),
+				"phone_number":      util.CreateRandomPhoneNumber(),
+				"password":          util.CreateRandomPassword(),
+				"verification_code": "123456",
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -240,8 +318,8 @@ func TestLogin(t *testing.T) {
 				"password":     util.CreateRandomString(10),
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
-				accessToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
-				refreshToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				accessToken, _, _ := testTokenMaker.CreateToken(userID, "", "", testConfig.AccessTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
 
 				err := service.CustomErr{}
 
@@ -366,6 +444,31 @@ func TestLogin(t *testing.T) {
 				require.Nil(t, responseBody.Data)
 			},
 		},
+		{
+			name: "로그인 잠금",
+			body: gin.H{
+				"phone_number": util.CreateRandomPhoneNumber(),
+				"password":     util.CreateRandomString(10),
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusLocked, Err: fmt.Errorf("account is locked"), RetryAfter: 90*time.Second + time.Millisecond}
+
+				mockService.EXPECT().
+					Login(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.LoginResponseBody{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				require.Equal(t, recorder.Header().Get("Retry-After"), "91")
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "Internal Service Error",
 			body: gin.H{
@@ -436,8 +539,8 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
-				accessToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
-				refreshToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				accessToken, _, _ := testTokenMaker.CreateToken(userID, "", "", testConfig.AccessTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
 
 				err := service.CustomErr{}
 
@@ -465,7 +568,7 @@ func TestRenewAccessToken(t *testing.T) {
 				return gin.H{}
 			},
 			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
-				refreshToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
 
 				mockService.EXPECT().
 					RenewAccessToken(gomock.Any(), gomock.Any()).
@@ -489,7 +592,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
-				refreshToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
 
 				mockService.EXPECT().
 					RenewAccessToken(gomock.Any(), gomock.Any()).
@@ -513,7 +616,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
-				refreshToken, _, _ := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
 
 				err := service.NewErrInternalServer(sql.ErrConnDone)
 
@@ -560,3 +663,1248 @@ func TestRenewAccessToken(t *testing.T) {
 		})
 	}
 }
+
+func TestLogout(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		body          func(refreshToken string) gin.H
+		buildStubs    func(mockService *mockservice.MockService) (string, service.CustomErr)
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: func(refreshToken string) gin.H {
+				return gin.H{
+					"refresh_token": refreshToken,
+				}
+			},
+			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
+
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					Logout(gomock.Any(), gomock.Eq(service.LogoutParams{LogoutRequestBody: dto.LogoutRequestBody{RefreshToken: refreshToken}})).
+					Times(1).
+					Return(err)
+
+				return refreshToken, err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "refresh 토큰 미입력",
+			body: func(refreshToken string) gin.H {
+				return gin.H{}
+			},
+			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
+				mockService.EXPECT().
+					Logout(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return "", service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("refresh_token")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "string 타입이 아닌 refresh 토큰 입력",
+			body: func(refreshToken string) gin.H {
+				return gin.H{
+					"refresh_token": util.CreateRandomInt32(1, 10),
+				}
+			},
+			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
+				mockService.EXPECT().
+					Logout(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return "", service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(response.ErrType("refresh_token", "string")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: func(refreshToken string) gin.H {
+				return gin.H{
+					"refresh_token": refreshToken,
+				}
+			},
+			buildStubs: func(mockService *mockservice.MockService) (string, service.CustomErr) {
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
+
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					Logout(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return refreshToken, err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			refreshToken, errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body(refreshToken))
+			require.NoError(t, err)
+
+			url := "/api/auth/logout"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+// 로그아웃 후 refresh 토큰을 access 토큰처럼 사용하는 경우
+func TestRefreshTokenAsBearerAfterLogout(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	refreshToken, _, err := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mockservice.NewMockService(ctrl)
+	controller := newTestController(t, mockService)
+
+	mockService.EXPECT().
+		Logout(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(service.CustomErr{})
+	mockService.EXPECT().
+		GetUserProfile(gomock.Any(), gomock.Any()).
+		Times(0)
+	mockService.EXPECT().
+		GetProductList(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/api/auth/logout", bytes.NewReader(data))
+	require.NoError(t, err)
+	controller.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	for _, url := range []string{"/api/users/me", "/api/products/?page=1"} {
+		recorder = httptest.NewRecorder()
+		request, err = http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		request.Header.Set(middleware.AuthorizationHeaderKey, fmt.Sprintf("%s %s", middleware.AuthorizationTypeBearer, refreshToken))
+
+		controller.router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	currentPassword := util.CreateRandomPassword()
+	newPassword := util.CreateRandomPassword()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"current_password": currentPassword,
+				"new_password":     newPassword,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					ChangePassword(gomock.Any(), gomock.Eq(service.ChangePasswordParams{
+						UserID: userID,
+						ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+							CurrentPassword: currentPassword,
+							NewPassword:     newPassword,
+						},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			body: gin.H{
+				"current_password": currentPassword,
+				"new_password":     newPassword,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ChangePassword(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "현재 비밀번호 미입력",
+			body: gin.H{
+				"new_password": newPassword,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ChangePassword(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("current_password")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "비밀번호 정책에 맞지 않는 새 비밀번호 입력",
+			body: gin.H{
+				"current_password": currentPassword,
+				"new_password":     util.CreateRandomString(10),
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ChangePassword(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrPasswordCharClasses("new_password", fmt.Sprint(testConfig.PasswordMinCharClasses))).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"current_password": currentPassword,
+				"new_password":     newPassword,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					ChangePassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/password"
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestSendVerificationCode(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"purpose":      "register",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					SendVerificationCode(gomock.Any(), gomock.Eq(service.SendVerificationCodeParams{
+						PhoneNumber: phoneNumber,
+						Purpose:     "register",
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "휴대폰번호 미입력",
+			body: gin.H{
+				"purpose": "register",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					SendVerificationCode(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("phone_number")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "지원하지 않는 목적 입력",
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"purpose":      util.CreateRandomString(10),
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					SendVerificationCode(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrOneOf("purpose", "register password_reset change_phone_number")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"purpose":      "password_reset",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					SendVerificationCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/verification"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+	newPassword := util.CreateRandomPassword()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"phone_number":      phoneNumber,
+				"verification_code": "123456",
+				"new_password":      newPassword,
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Eq(service.ResetPasswordParams{
+						ResetPasswordRequestBody: dto.ResetPasswordRequestBody{
+							PhoneNumber:      phoneNumber,
+							VerificationCode: "123456",
+							NewPassword:      newPassword,
+						},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증번호 미입력",
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"new_password": newPassword,
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("verification_code")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "휴대폰번호가 포함된 새 비밀번호 입력",
+			body: gin.H{
+				"phone_number":      phoneNumber,
+				"verification_code": "123456",
+				"new_password":      "Aa!" + phoneNumber,
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrPasswordPhoneNumber("new_password")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"phone_number":      phoneNumber,
+				"verification_code": "123456",
+				"new_password":      newPassword,
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/password/reset"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	twoFactorToken := util.CreateRandomString(32)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "인증 코드로 성공",
+			body: gin.H{
+				"two_factor_token": twoFactorToken,
+				"code":             "123456",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				accessToken, _, _ := testTokenMaker.CreateToken(userID, "", "", testConfig.AccessTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
+
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, params service.LoginTwoFactorParams) (dto.LoginResponseBody, service.CustomErr) {
+						require.Equal(t, params.TwoFactorToken, twoFactorToken)
+						require.Equal(t, params.Code, "123456")
+						require.Empty(t, params.RecoveryCode)
+						return dto.LoginResponseBody{
+							AccessToken:  accessToken,
+							RefreshToken: refreshToken,
+						}, err
+					})
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "복구 코드로 성공",
+			body: gin.H{
+				"two_factor_token": twoFactorToken,
+				"recovery_code":    "abcde-fghij",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				accessToken, _, _ := testTokenMaker.CreateToken(userID, "", "", testConfig.AccessTokenDuration)
+
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.LoginResponseBody{AccessToken: accessToken}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 대기 토큰 미입력",
+			body: gin.H{
+				"code": "123456",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("two_factor_token")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 코드, 복구 코드 미입력",
+			body: gin.H{
+				"two_factor_token": twoFactorToken,
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("code")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "형식에 맞지 않는 인증 코드 입력",
+			body: gin.H{
+				"two_factor_token": twoFactorToken,
+				"code":             "12345a",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrVerificationCode("code")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"two_factor_token": twoFactorToken,
+				"code":             "123456",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					LoginTwoFactor(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.LoginResponseBody{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/login/2fa"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestEnrollTwoFactor(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	password := util.CreateRandomPassword()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"password": password,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					EnrollTwoFactor(gomock.Any(), gomock.Eq(service.EnrollTwoFactorParams{
+						UserID:                     userID,
+						EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password},
+					})).
+					Times(1).
+					Return(dto.EnrollTwoFactorResponse{
+						Secret:        util.CreateRandomString(32),
+						URI:           "otpauth://totp/pha",
+						QRCode:        util.CreateRandomString(32),
+						RecoveryCodes: []string{"abcde-fghij"},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			body: gin.H{
+				"password": password,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					EnrollTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "비밀번호 미입력",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					EnrollTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("password")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"password": password,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					EnrollTwoFactor(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.EnrollTwoFactorResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/2fa"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"code": "123456",
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Eq(service.ConfirmTwoFactorParams{
+						UserID:                      userID,
+						ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: "123456"},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			body: gin.H{
+				"code": "123456",
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "인증 코드 미입력",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("code")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"code": "123456",
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/auth/2fa/confirm"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestIntrospectToken(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	accessToken, payload, _ := testTokenMaker.CreateToken(userID, "", util.CreateRandomString(36), testConfig.AccessTokenDuration)
+
+	testCases := []struct {
+		name          string
+		body          url.Values
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: url.Values{"token": {accessToken}},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(testConfig.IntrospectionClientID, testConfig.IntrospectionClientSecret)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Eq(service.IntrospectTokenParams{Token: accessToken})).
+					Times(1).
+					Return(dto.IntrospectTokenResponse{
+						Active:        true,
+						TokenType:     "access_token",
+						UserID:        userID,
+						SessionID:     payload.SessionID,
+						SessionStatus: "active",
+						IssuedAt:      payload.IssuedAt.Unix(),
+						ExpiredAt:     payload.ExpiredAt.Unix(),
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+
+				var result dto.IntrospectTokenResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &result)
+				require.NoError(t, err)
+				require.True(t, result.Active)
+				require.Equal(t, result.UserID, userID)
+				require.Equal(t, result.ExpiredAt, payload.ExpiredAt.Unix())
+			},
+		},
+		{
+			name: "유효하지 않은 토큰",
+			body: url.Values{"token": {util.CreateRandomString(50)}},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(testConfig.IntrospectionClientID, testConfig.IntrospectionClientSecret)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.IntrospectTokenResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				require.JSONEq(t, `{"active":false}`, recorder.Body.String())
+			},
+		},
+		{
+			name: "토큰 미입력",
+			body: url.Values{},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(testConfig.IntrospectionClientID, testConfig.IntrospectionClientSecret)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("token")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "서비스 인증 정보가 없는 경우",
+			body: url.Values{"token": {accessToken}},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "회원 access 토큰으로 요청한 경우",
+			body: url.Values{"token": {accessToken}},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: url.Values{"token": {accessToken}},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(testConfig.IntrospectionClientID, testConfig.IntrospectionClientSecret)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					IntrospectToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.IntrospectTokenResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/introspect"
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body.Encode()))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
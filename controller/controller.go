@@ -7,20 +7,26 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/gitaepark/pha/service"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/token"
 	"github.com/gitaepark/pha/util/validator"
 	"github.com/rs/zerolog/log"
 )
 
 type Controller struct {
-	config  util.Config
-	service service.Service
-	router  *gin.Engine
+	config          util.Config
+	tokenMaker      token.TokenMaker
+	revocationStore revocation.Store
+	service         service.Service
+	router          *gin.Engine
 }
 
-func NewController(config util.Config, service service.Service) *Controller {
+func NewController(config util.Config, tokenMaker token.TokenMaker, revocationStore revocation.Store, service service.Service) *Controller {
 	controller := &Controller{
-		config:  config,
-		service: service,
+		config:          config,
+		tokenMaker:      tokenMaker,
+		revocationStore: revocationStore,
+		service:         service,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -28,6 +34,10 @@ func NewController(config util.Config, service service.Service) *Controller {
 		if err != nil {
 			log.Fatal().Msg("cannot create validation")
 		}
+		err = v.RegisterValidation("verification_code", validator.ValidateVerificationCode)
+		if err != nil {
+			log.Fatal().Msg("cannot create validation")
+		}
 		err = v.RegisterValidation("date", validator.ValidateDate)
 		if err != nil {
 			log.Fatal().Msg("cannot create validation")
@@ -36,6 +46,13 @@ func NewController(config util.Config, service service.Service) *Controller {
 		if err != nil {
 			log.Fatal().Msg("cannot create validation")
 		}
+		err = validator.RegisterPasswordValidation(v, "password", validator.PasswordPolicy{
+			MinLength:      config.PasswordMinLength,
+			MinCharClasses: config.PasswordMinCharClasses,
+		})
+		if err != nil {
+			log.Fatal().Msg("cannot create validation")
+		}
 	}
 
 	controller.setupRouter()
@@ -47,13 +64,19 @@ func (controller *Controller) setupRouter() {
 	controller.router = gin.Default()
 
 	controller.setHealthCheck()
+	controller.setJWKS()
 
 	controller.setAuthRouter()
+	controller.setAuthEventRouter()
+	controller.setUserRouter()
+	controller.setSessionRouter()
+	controller.setApiKeyRouter()
+	controller.setStoreRouter()
 	controller.setProductRouter()
 }
 
-func (controller *Controller) Run(address string) error {
-	return controller.router.Run(address)
+func (controller *Controller) Handler() http.Handler {
+	return controller.router
 }
 
 func (controller *Controller) setHealthCheck() {
@@ -61,3 +84,15 @@ func (controller *Controller) setHealthCheck() {
 		ctx.JSON(http.StatusOK, gin.H{"message": "OK"})
 	})
 }
+
+// 다른 서비스의 토큰 검증을 위한 공개키 목록 (RFC 7517 형식)
+func (controller *Controller) setJWKS() {
+	controller.router.GET("/.well-known/jwks.json", func(ctx *gin.Context) {
+		jwks := token.JWKS{Keys: []token.JWK{}}
+		if maker, ok := controller.tokenMaker.(*token.JWTMaker); ok {
+			jwks = maker.JWKS()
+		}
+
+		ctx.JSON(http.StatusOK, jwks)
+	})
+}
@@ -11,17 +11,28 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gitaepark/pha/service"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/token"
 	"github.com/stretchr/testify/require"
 )
 
 var testConfig = util.Config{
-	JWTSecret:            util.CreateRandomString(32),
-	AccessTokenDuration:  time.Minute,
-	RefreshTokenDuration: time.Minute,
+	JWTSecret:              util.CreateRandomString(32),
+	AccessTokenDuration:    time.Minute,
+	RefreshTokenDuration:   time.Minute,
+	PasswordMinLength:      8,
+	PasswordMinCharClasses: 2,
+
+	IntrospectionClientID:     "pha-internal",
+	IntrospectionClientSecret: util.CreateRandomString(32),
 }
 
+var testTokenMaker, _ = token.NewTokenMaker(testConfig)
+
+var testRevocationStore = revocation.NewMemoryStore()
+
 func newTestController(t *testing.T, service service.Service) *Controller {
-	return NewController(testConfig, service)
+	return NewController(testConfig, testTokenMaker, testRevocationStore, service)
 }
 
 func TestMain(m *testing.M) {
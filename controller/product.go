@@ -6,17 +6,29 @@ import (
 	"github.com/gitaepark/pha/dto"
 	"github.com/gitaepark/pha/middleware"
 	"github.com/gitaepark/pha/service"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/util/token"
 )
 
 func (controller *Controller) setProductRouter() {
 	// authorization
-	productRoutes := controller.router.Group("/api/products").Use(middleware.AuthMiddleware(controller.config))
+	productRoutes := controller.router.Group("/api/products").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, controller.service))
+
+	// 상품 권한은 회원 역할이 아닌 매장 역할로 service에서 확인
+	// 매장 직원은 메뉴 조회만 가능하며 가격, 원가 등 상품 정보 변경 불가
+	// API 키 권한 범위
+	productReadScope := middleware.RequireScope(dto.ApiKeyScopeProductsRead)
+	productWriteScope := middleware.RequireScope(dto.ApiKeyScopeProductsWrite)
 
 	// 상품 등록 api
-	productRoutes.POST("/", func(ctx *gin.Context) {
-		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*jwt.Payload)
+	productRoutes.POST("/", productWriteScope, func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
 
+		var reqHeader dto.StoreRequestHeader
+		// req header dto 검증
+		if err := ctx.ShouldBindHeader(&reqHeader); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqHeader, "header")
+			return
+		}
 		var reqBody dto.CreateProductRequestBody
 		// req body dto 검증
 		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
@@ -26,6 +38,7 @@ func (controller *Controller) setProductRouter() {
 
 		params := service.CreateProductParams{
 			UserID:                   authPayload.UserID,
+			StoreRequestHeader:       reqHeader,
 			CreateProductRequestBody: reqBody,
 		}
 
@@ -40,9 +53,15 @@ func (controller *Controller) setProductRouter() {
 	})
 
 	// 상품 목록 조회 api
-	productRoutes.GET("/", func(ctx *gin.Context) {
-		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*jwt.Payload)
+	productRoutes.GET("/", productReadScope, func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
 
+		var reqHeader dto.StoreRequestHeader
+		// req header dto 검증
+		if err := ctx.ShouldBindHeader(&reqHeader); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqHeader, "header")
+			return
+		}
 		var reqQuery dto.GetProductListRequestQuery
 		// req query dto 검증
 		if err := ctx.ShouldBindQuery(&reqQuery); err != nil {
@@ -52,6 +71,7 @@ func (controller *Controller) setProductRouter() {
 
 		params := service.GetProductListParams{
 			UserID:                     authPayload.UserID,
+			StoreRequestHeader:         reqHeader,
 			GetProductListRequestQuery: reqQuery,
 		}
 
@@ -66,8 +86,8 @@ func (controller *Controller) setProductRouter() {
 	})
 
 	// 상품 상세 조회 api
-	productRoutes.GET("/:id", func(ctx *gin.Context) {
-		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*jwt.Payload)
+	productRoutes.GET("/:id", productReadScope, func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
 
 		var reqPath dto.GetProductRequestPath
 		// req path dto 검증
@@ -92,8 +112,8 @@ func (controller *Controller) setProductRouter() {
 	})
 
 	// 상품 수정 api
-	productRoutes.PATCH("/:id", func(ctx *gin.Context) {
-		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*jwt.Payload)
+	productRoutes.PATCH("/:id", productWriteScope, func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
 
 		var reqPath dto.UpdateProductRequestPath
 		// req path dto 검증
@@ -123,8 +143,8 @@ func (controller *Controller) setProductRouter() {
 		response.NewOkResponse(ctx, nil)
 	})
 
-	productRoutes.DELETE("/:id", func(ctx *gin.Context) {
-		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*jwt.Payload)
+	productRoutes.DELETE("/:id", productWriteScope, func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
 
 		var reqPath dto.DeleteProductRequestPath
 		// req path dto 검증
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -18,7 +19,7 @@ import (
 	"github.com/gitaepark/pha/service"
 	mockservice "github.com/gitaepark/pha/service/mock"
 	"github.com/gitaepark/pha/util"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/util/token"
 	"github.com/gitaepark/pha/util/validator"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -26,7 +27,8 @@ import (
 
 func TestCreateProduct(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	product := createRandomProduct(userID)
+	storeID := util.CreateRandomInt64(1, 10)
+	product := createRandomProduct(storeID)
 
 	testCases := []struct {
 		name          string
@@ -48,7 +50,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -68,6 +70,140 @@ func TestCreateProduct(t *testing.T) {
 				require.Nil(t, responseBody.Data)
 			},
 		},
+		{
+			name: "매장 지정",
+			body: gin.H{
+				"category":        product.Category,
+				"price":           product.Price,
+				"cost":            product.Cost,
+				"name":            product.Name,
+				"description":     product.Description,
+				"barcode":         product.Barcode,
+				"expiration_date": product.ExpirationDate,
+				"size":            product.Size,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+				request.Header.Set("X-Store-ID", fmt.Sprint(storeID))
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, params service.CreateProductParams) service.CustomErr {
+						require.Equal(t, params.UserID, userID)
+						require.Equal(t, params.StoreID, storeID)
+						return err
+					})
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "int64 타입이 아닌 매장 id",
+			body: gin.H{
+				"category":        product.Category,
+				"price":           product.Price,
+				"cost":            product.Cost,
+				"name":            product.Name,
+				"description":     product.Description,
+				"barcode":         product.Barcode,
+				"expiration_date": product.ExpirationDate,
+				"size":            product.Size,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+				request.Header.Set("X-Store-ID", util.CreateRandomString(5))
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, response.ErrParseString.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "매장 직원 권한",
+			body: gin.H{
+				"category":        product.Category,
+				"price":           product.Price,
+				"cost":            product.Cost,
+				"name":            product.Name,
+				"description":     product.Description,
+				"barcode":         product.Barcode,
+				"expiration_date": product.ExpirationDate,
+				"size":            product.Size,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleStaff, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("store owner permission required")}
+
+				mockService.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 관리자",
+			body: gin.H{
+				"category":        product.Category,
+				"price":           product.Price,
+				"cost":            product.Cost,
+				"name":            product.Name,
+				"description":     product.Description,
+				"barcode":         product.Barcode,
+				"expiration_date": product.ExpirationDate,
+				"size":            product.Size,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleAdmin, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only access your store")}
+
+				mockService.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "카테고리 미입력",
 			body: gin.H{
@@ -80,7 +216,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -110,7 +246,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -140,7 +276,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -169,7 +305,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -199,7 +335,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -228,7 +364,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -258,7 +394,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -287,7 +423,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -317,7 +453,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -347,7 +483,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -376,7 +512,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -406,7 +542,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -435,7 +571,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -465,7 +601,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -494,7 +630,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":        product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -524,7 +660,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -554,7 +690,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -583,7 +719,7 @@ func TestCreateProduct(t *testing.T) {
 				"expiration_date": product.ExpirationDate,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -613,7 +749,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -643,7 +779,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            util.CreateRandomString(10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -673,7 +809,7 @@ func TestCreateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -726,7 +862,9 @@ func TestCreateProduct(t *testing.T) {
 
 func TestGetProductList(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	product := createRandomProduct(userID)
+	storeID := util.CreateRandomInt64(1, 10)
+	product := createRandomProduct(storeID)
+	cursor := util.CreateRandomString(20) + "." + util.CreateRandomString(20)
 
 	testCases := []struct {
 		name          string
@@ -739,7 +877,7 @@ func TestGetProductList(t *testing.T) {
 			name: "성공",
 			uri:  "?page=" + fmt.Sprint(util.CreateRandomInt32(1, 5)),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -761,11 +899,90 @@ func TestGetProductList(t *testing.T) {
 				require.NotEmpty(t, responseBody.Data)
 			},
 		},
+		{
+			name: "매장 지정",
+			uri:  "?page=1",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+				request.Header.Set("X-Store-ID", fmt.Sprint(storeID))
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(service.GetProductListParams{
+						UserID:                     userID,
+						StoreRequestHeader:         dto.StoreRequestHeader{StoreID: storeID},
+						GetProductListRequestQuery: dto.GetProductListRequestQuery{Page: 1},
+					})).
+					Times(1).
+					Return(dto.GetProductListResponse{
+						List: []dto.GetProductResponse{product},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "직원 권한",
+			uri:  "?page=1",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleStaff, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetProductListResponse{
+						List: []dto.GetProductResponse{product},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 관리자",
+			uri:  "?page=1",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleAdmin, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only access your store")}
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetProductListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "검색 성공",
 			uri:  "?page=" + fmt.Sprint(util.CreateRandomInt32(1, 5)) + "&keyword=" + fmt.Sprint(product.Name),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -787,11 +1004,238 @@ func TestGetProductList(t *testing.T) {
 				require.NotEmpty(t, responseBody.Data)
 			},
 		},
+		{
+			name: "커서 입력",
+			uri:  "?cursor=" + cursor,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(service.GetProductListParams{
+						UserID:                     userID,
+						GetProductListRequestQuery: dto.GetProductListRequestQuery{Cursor: cursor},
+					})).
+					Times(1).
+					Return(dto.GetProductListResponse{
+						List: []dto.GetProductResponse{product},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "조회 개수 지정",
+			uri:  "?page=2&size=20",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(service.GetProductListParams{
+						UserID:                     userID,
+						GetProductListRequestQuery: dto.GetProductListRequestQuery{Page: 2, Size: 20},
+					})).
+					Times(1).
+					Return(dto.GetProductListResponse{
+						List:       []dto.GetProductResponse{product},
+						TotalCount: 21,
+						TotalPages: 2,
+						HasNext:    false,
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+
+				data := responseBody.Data.(map[string]interface{})
+				require.Equal(t, float64(21), data["total_count"])
+				require.Equal(t, float64(2), data["total_pages"])
+				require.Equal(t, false, data["has_next"])
+			},
+		},
+		{
+			name: "int32 타입이 아닌 조회 개수 입력",
+			uri:  "?page=1&size=" + util.CreateRandomString(5),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(response.ErrParseString).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "필터 입력",
+			uri:  "?page=1&category=" + product.Category + "&product_size=small&min_price=1000&max_price=2000&expiration_date_to=2023-01-07&expiring_within_days=7&created_date_from=2023-01-01",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+				minPrice, maxPrice, expiringWithinDays := int32(1000), int32(2000), int32(7)
+
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(service.GetProductListParams{
+						UserID: userID,
+						GetProductListRequestQuery: dto.GetProductListRequestQuery{
+							Page:               1,
+							Category:           product.Category,
+							ProductSize:        "small",
+							MinPrice:           &minPrice,
+							MaxPrice:           &maxPrice,
+							ExpirationDateTo:   "2023-01-07",
+							ExpiringWithinDays: &expiringWithinDays,
+							CreatedDateFrom:    "2023-01-01",
+						},
+					})).
+					Times(1).
+					Return(dto.GetProductListResponse{
+						List: []dto.GetProductResponse{product},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "상품 사이즈 형식 오류",
+			uri:  "?page=1&product_size=" + util.CreateRandomString(5),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrProductSize("product_size")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "최대 페이지 초과",
+			uri:  "?page=10001",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrMax("page", "10000")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "음수 가격 입력",
+			uri:  "?page=1&min_price=-1",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrGte("min_price", "0")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "음수 만료 기간 입력",
+			uri:  "?page=1&expiring_within_days=-1",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrGte("expiring_within_days", "0")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "날짜 형식 오류",
+			uri:  "?page=1&expiration_date_from=" + util.CreateRandomString(8),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrDate("expiration_date_from")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "페이지 미입력",
 			uri:  "",
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -814,7 +1258,7 @@ func TestGetProductList(t *testing.T) {
 			name: "int32 타입이 아닌 페이지 입력",
 			uri:  "?page=" + util.CreateRandomString(5),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -837,7 +1281,7 @@ func TestGetProductList(t *testing.T) {
 			name: "Internal Service Error",
 			uri:  "?page=" + fmt.Sprint(util.CreateRandomInt32(1, 5)),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -887,7 +1331,8 @@ func TestGetProductList(t *testing.T) {
 
 func TestGetProduct(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	product := createRandomProduct(userID)
+	storeID := util.CreateRandomInt64(1, 10)
+	product := createRandomProduct(storeID)
 
 	testCases := []struct {
 		name          string
@@ -900,7 +1345,7 @@ func TestGetProduct(t *testing.T) {
 			name: "성공",
 			uri:  fmt.Sprint(product.ID),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -920,11 +1365,81 @@ func TestGetProduct(t *testing.T) {
 				require.NotEmpty(t, responseBody.Data)
 			},
 		},
+		{
+			name: "API 키 인증",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddApiKeyAuthorization(t, request)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&token.Payload{UserID: userID, ApiKeyID: 1, Scopes: []string{dto.ApiKeyScopeProductsRead}}, err)
+				mockService.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(product, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+			},
+		},
+		{
+			name: "API 키 권한 범위 부족",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddApiKeyAuthorization(t, request)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&token.Payload{UserID: userID, ApiKeyID: 1, Scopes: []string{dto.ApiKeyScopeProductsWrite}}, service.CustomErr{})
+				mockService.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusForbidden)
+			},
+		},
+		{
+			name: "유효하지 않은 API 키",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddApiKeyAuthorization(t, request)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid api key")}
+
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, err)
+				mockService.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+			},
+		},
 		{
 			name: "int64 타입이 아닌 id",
 			uri:  util.CreateRandomString(5),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -947,7 +1462,7 @@ func TestGetProduct(t *testing.T) {
 			name: "Internal Service Error",
 			uri:  fmt.Sprint(product.ID),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -997,7 +1512,8 @@ func TestGetProduct(t *testing.T) {
 
 func TestUpdateProduct(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	product := createRandomProduct(userID)
+	storeID := util.CreateRandomInt64(1, 10)
+	product := createRandomProduct(storeID)
 
 	testCases := []struct {
 		name          string
@@ -1012,7 +1528,7 @@ func TestUpdateProduct(t *testing.T) {
 				"category": product.Category,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -1032,13 +1548,65 @@ func TestUpdateProduct(t *testing.T) {
 				require.Nil(t, responseBody.Data)
 			},
 		},
+		{
+			name: "매장 직원 권한",
+			body: gin.H{
+				"price": product.Price,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleStaff, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("store owner permission required")}
+
+				mockService.EXPECT().
+					UpdateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 관리자",
+			body: gin.H{
+				"price": product.Price,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleAdmin, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only access your store")}
+
+				mockService.EXPECT().
+					UpdateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
 		{
 			name: "string 타입이 아닌 카테고리 입력",
 			body: gin.H{
 				"category": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1061,7 +1629,7 @@ func TestUpdateProduct(t *testing.T) {
 				"category": util.CreateRandomString(101),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1084,7 +1652,7 @@ func TestUpdateProduct(t *testing.T) {
 				"price": util.CreateRandomString(10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1107,7 +1675,7 @@ func TestUpdateProduct(t *testing.T) {
 				"cost": util.CreateRandomString(10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1130,7 +1698,7 @@ func TestUpdateProduct(t *testing.T) {
 				"name": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1153,7 +1721,7 @@ func TestUpdateProduct(t *testing.T) {
 				"name": util.CreateRandomString(101),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1176,7 +1744,7 @@ func TestUpdateProduct(t *testing.T) {
 				"description": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1199,7 +1767,7 @@ func TestUpdateProduct(t *testing.T) {
 				"barcode": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1222,7 +1790,7 @@ func TestUpdateProduct(t *testing.T) {
 				"expiration_date": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1245,7 +1813,7 @@ func TestUpdateProduct(t *testing.T) {
 				"expiration_date": util.CreateRandomString(10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1268,7 +1836,7 @@ func TestUpdateProduct(t *testing.T) {
 				"size": util.CreateRandomInt32(1, 10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1291,7 +1859,7 @@ func TestUpdateProduct(t *testing.T) {
 				"size": util.CreateRandomString(10),
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				mockService.EXPECT().
@@ -1321,7 +1889,7 @@ func TestUpdateProduct(t *testing.T) {
 				"size":            product.Size,
 			},
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -1374,7 +1942,8 @@ func TestUpdateProduct(t *testing.T) {
 
 func TestDeleteProduct(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	product := createRandomProduct(userID)
+	storeID := util.CreateRandomInt64(1, 10)
+	product := createRandomProduct(storeID)
 
 	testCases := []struct {
 		name          string
@@ -1387,7 +1956,7 @@ func TestDeleteProduct(t *testing.T) {
 			name: "성공",
 			uri:  fmt.Sprint(product.ID),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -1407,11 +1976,80 @@ func TestDeleteProduct(t *testing.T) {
 				require.Nil(t, responseBody.Data)
 			},
 		},
+		{
+			name: "매장 직원 권한",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleStaff, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("store owner permission required")}
+
+				mockService.EXPECT().
+					DeleteProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 관리자",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddRoleAuthorization(t, request, userID, repository.UserRoleAdmin, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only access your store")}
+
+				mockService.EXPECT().
+					DeleteProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "API 키 권한 범위 부족",
+			uri:  fmt.Sprint(product.ID),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddApiKeyAuthorization(t, request)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&token.Payload{UserID: userID, ApiKeyID: 1, Scopes: []string{dto.ApiKeyScopeProductsRead}}, service.CustomErr{})
+				mockService.EXPECT().
+					DeleteProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusForbidden)
+			},
+		},
 		{
 			name: "int64 타입이 아닌 id",
 			uri:  util.CreateRandomString(5),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.CustomErr{}
@@ -1434,7 +2072,7 @@ func TestDeleteProduct(t *testing.T) {
 			name: "Internal Service Error",
 			uri:  fmt.Sprint(product.ID),
 			setupAuth: func(t *testing.T, request *http.Request) {
-				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
 			},
 			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
 				err := service.NewErrInternalServer(sql.ErrConnDone)
@@ -1482,8 +2120,8 @@ func TestDeleteProduct(t *testing.T) {
 	}
 }
 
-func AddAuthorization(t *testing.T, request *http.Request, authorizationType string, userID int64, secret string, duration time.Duration) {
-	token, payload, err := jwt.CreateToken(userID, secret, duration)
+func AddAuthorization(t *testing.T, request *http.Request, authorizationType string, userID int64, tokenMaker token.TokenMaker, duration time.Duration) {
+	token, payload, err := tokenMaker.CreateToken(userID, "", "", duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -1491,10 +2129,24 @@ func AddAuthorization(t *testing.T, request *http.Request, authorizationType str
 	request.Header.Set(middleware.AuthorizationHeaderKey, authorizationHeader)
 }
 
-func createRandomProduct(userID int64) dto.GetProductResponse {
+func AddApiKeyAuthorization(t *testing.T, request *http.Request) {
+	authorizationHeader := fmt.Sprintf("%s %s", middleware.AuthorizationTypeApiKey, util.CreateRandomString(10))
+	request.Header.Set(middleware.AuthorizationHeaderKey, authorizationHeader)
+}
+
+func AddRoleAuthorization(t *testing.T, request *http.Request, userID int64, role repository.UserRole, tokenMaker token.TokenMaker, duration time.Duration) {
+	token, payload, err := tokenMaker.CreateToken(userID, string(role), "", duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	authorizationHeader := fmt.Sprintf("%s %s", middleware.AuthorizationTypeBearer, token)
+	request.Header.Set(middleware.AuthorizationHeaderKey, authorizationHeader)
+}
+
+func createRandomProduct(storeID int64) dto.GetProductResponse {
 	product := repository.Product{
 		ID:             util.CreateRandomInt64(1, 10),
-		UserID:         userID,
+		StoreID:        storeID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
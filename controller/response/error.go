@@ -3,6 +3,7 @@ package response
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,9 @@ import (
 var ErrParseString = fmt.Errorf("params invalid syntax")
 
 func NewErrResponse(ctx *gin.Context, cErr service.CustomErr) {
+	if cErr.RetryAfter > 0 {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(cErr.RetryAfter.Seconds()))))
+	}
 	ctx.AbortWithStatusJSON(cErr.Code, gin.H{"meta": gin.H{"code": cErr.Code, "message": cErr.Err.Error()}, "data": nil})
 }
 
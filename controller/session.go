@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
+)
+
+func (controller *Controller) setSessionRouter() {
+	// authorization
+	sessionRoutes := controller.router.Group("/api/auth/sessions").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil))
+
+	// 로그인 세션 목록 조회 api
+	sessionRoutes.GET("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		params := service.GetSessionListParams{
+			UserID:    authPayload.UserID,
+			SessionID: authPayload.SessionID,
+		}
+
+		// 로그인 세션 목록 조회
+		result, cErr := controller.service.GetSessionList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 로그인 세션 일괄 삭제 api
+	sessionRoutes.DELETE("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqQuery dto.DeleteSessionListRequestQuery
+		// req query dto 검증
+		if err := ctx.ShouldBindQuery(&reqQuery); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqQuery, "form")
+			return
+		}
+
+		params := service.DeleteSessionListParams{
+			UserID:                        authPayload.UserID,
+			SessionID:                     authPayload.SessionID,
+			DeleteSessionListRequestQuery: reqQuery,
+		}
+
+		// 로그인 세션 일괄 삭제
+		cErr := controller.service.DeleteSessionList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 로그인 세션 삭제 api
+	sessionRoutes.DELETE("/:id", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqPath dto.DeleteSessionRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+
+		params := service.DeleteSessionParams{
+			UserID:                   authPayload.UserID,
+			DeleteSessionRequestPath: reqPath,
+		}
+
+		// 로그인 세션 삭제
+		cErr := controller.service.DeleteSession(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+}
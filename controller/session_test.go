@@ -0,0 +1,361 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	mockservice "github.com/gitaepark/pha/service/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/device"
+	"github.com/gitaepark/pha/util/validator"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSessionList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	session := createRandomSession()
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetSessionListResponse{
+						List: []dto.GetSessionResponse{session},
+					}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					GetSessionList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					GetSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetSessionListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/sessions/"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestDeleteSession(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	session := createRandomSession()
+
+	testCases := []struct {
+		name          string
+		uri           string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  session.ID,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					DeleteSession(gomock.Any(), gomock.Eq(service.DeleteSessionParams{
+						UserID:                   userID,
+						DeleteSessionRequestPath: dto.DeleteSessionRequestPath{ID: session.ID},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  session.ID,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					DeleteSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/sessions/" + tc.uri
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestDeleteSessionList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		uri           string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "전체 세션 삭제 성공",
+			uri:  "",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					DeleteSessionList(gomock.Any(), gomock.Eq(service.DeleteSessionListParams{
+						UserID: userID,
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "현재 세션 제외 삭제 성공",
+			uri:  "?except=current",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					DeleteSessionList(gomock.Any(), gomock.Eq(service.DeleteSessionListParams{
+						UserID: userID,
+						DeleteSessionListRequestQuery: dto.DeleteSessionListRequestQuery{
+							Except: "current",
+						},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "지원하지 않는 except 입력",
+			uri:  "?except=" + util.CreateRandomString(5),
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					DeleteSessionList(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrOneOf("except", "current")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  "",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					DeleteSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/auth/sessions/" + tc.uri
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func createRandomSession() dto.GetSessionResponse {
+	return dto.GetSessionResponse{
+		ID:        uuid.NewString(),
+		Device:    device.Parse(userAgent),
+		UserAgent: userAgent,
+		ClientIp:  clientIp,
+		IsCurrent: true,
+		CreatedAt: time.Now(),
+		ExpiredAt: time.Now().Add(testConfig.RefreshTokenDuration),
+	}
+}
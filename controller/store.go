@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
+)
+
+func (controller *Controller) setStoreRouter() {
+	// authorization
+	storeRoutes := controller.router.Group("/api/stores").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil))
+
+	// 매장 생성 api
+	storeRoutes.POST("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.CreateStoreRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.CreateStoreParams{
+			UserID:                 authPayload.UserID,
+			CreateStoreRequestBody: reqBody,
+		}
+
+		// 매장 생성
+		result, cErr := controller.service.CreateStore(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 소속 매장 목록 조회 api
+	storeRoutes.GET("/", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		params := service.GetStoreListParams{
+			UserID: authPayload.UserID,
+		}
+
+		// 소속 매장 목록 조회
+		result, cErr := controller.service.GetStoreList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 매장 초대 api
+	storeRoutes.POST("/:id/invitations", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqPath dto.CreateStoreInvitationRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+		var reqBody dto.CreateStoreInvitationRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.CreateStoreInvitationParams{
+			UserID:                           authPayload.UserID,
+			CreateStoreInvitationRequestPath: reqPath,
+			CreateStoreInvitationRequestBody: reqBody,
+		}
+
+		// 매장 초대
+		cErr := controller.service.CreateStoreInvitation(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 받은 매장 초대 목록 조회 api
+	storeRoutes.GET("/invitations", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		params := service.GetStoreInvitationListParams{
+			UserID: authPayload.UserID,
+		}
+
+		// 받은 매장 초대 목록 조회
+		result, cErr := controller.service.GetStoreInvitationList(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 매장 초대 수락 api
+	storeRoutes.POST("/invitations/:id/accept", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqPath dto.AcceptStoreInvitationRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+
+		params := service.AcceptStoreInvitationParams{
+			UserID:                           authPayload.UserID,
+			AcceptStoreInvitationRequestPath: reqPath,
+		}
+
+		// 매장 초대 수락
+		cErr := controller.service.AcceptStoreInvitation(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 매장 회원 삭제 api
+	storeRoutes.DELETE("/:id/members/:user_id", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqPath dto.DeleteStoreMemberRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+
+		params := service.DeleteStoreMemberParams{
+			UserID:                       authPayload.UserID,
+			DeleteStoreMemberRequestPath: reqPath,
+		}
+
+		// 매장 회원 삭제
+		cErr := controller.service.DeleteStoreMember(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+}
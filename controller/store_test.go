@@ -0,0 +1,688 @@
+package controller
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/service"
+	mockservice "github.com/gitaepark/pha/service/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/validator"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStore(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	storeID := util.CreateRandomInt64(1, 10)
+	name := util.CreateRandomString(10)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			body: gin.H{
+				"name": name,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					CreateStore(gomock.Any(), gomock.Eq(service.CreateStoreParams{
+						UserID:                 userID,
+						CreateStoreRequestBody: dto.CreateStoreRequestBody{Name: name},
+					})).
+					Times(1).
+					Return(dto.CreateStoreResponse{ID: storeID}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Equal(t, responseBody.Meta.Message, "ok")
+				require.Equal(t, responseBody.Data.(map[string]interface{})["id"], float64(storeID))
+			},
+		},
+		{
+			name: "인증 헤더 미입력",
+			body: gin.H{
+				"name": name,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStore(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusUnauthorized)
+			},
+		},
+		{
+			name: "매장명 미입력",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStore(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusBadRequest)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrRequired("name")).Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "최대 길이를 초과한 매장명",
+			body: gin.H{
+				"name": util.CreateRandomString(101),
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStore(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrMax("name", "100")).Err.Error())
+			},
+		},
+		{
+			name: "Internal Service Error",
+			body: gin.H{
+				"name": name,
+			},
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					CreateStore(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.CreateStoreResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, errService.Code)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/stores/"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestGetStoreList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	store := dto.GetStoreResponse{
+		ID:        util.CreateRandomInt64(1, 10),
+		Name:      util.CreateRandomString(10),
+		Role:      repository.StoreMemberRoleOwner,
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request)
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetStoreList(gomock.Any(), gomock.Eq(service.GetStoreListParams{UserID: userID})).
+					Times(1).
+					Return(dto.GetStoreListResponse{List: []dto.GetStoreResponse{store}}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					GetStoreList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetStoreListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/stores/"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestCreateStoreInvitation(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	storeID := util.CreateRandomInt64(1, 10)
+	phoneNumber := util.CreateRandomPhoneNumber()
+
+	testCases := []struct {
+		name          string
+		uri           string
+		body          gin.H
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  fmt.Sprint(storeID),
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"role":         "staff",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Eq(service.CreateStoreInvitationParams{
+						UserID:                           userID,
+						CreateStoreInvitationRequestPath: dto.CreateStoreInvitationRequestPath{ID: storeID},
+						CreateStoreInvitationRequestBody: dto.CreateStoreInvitationRequestBody{PhoneNumber: phoneNumber, Role: "staff"},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "휴대폰 번호 형식이 아닌 경우",
+			uri:  fmt.Sprint(storeID),
+			body: gin.H{
+				"phone_number": util.CreateRandomString(11),
+				"role":         "staff",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrPhoneNumber("phone_number")).Err.Error())
+			},
+		},
+		{
+			name: "지원하지 않는 역할 입력",
+			uri:  fmt.Sprint(storeID),
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"role":         "admin",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, service.NewErrBadRequest(validator.ErrOneOf("role", "owner staff")).Err.Error())
+			},
+		},
+		{
+			name: "int64 타입이 아닌 id",
+			uri:  util.CreateRandomString(5),
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"role":         "staff",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  fmt.Sprint(storeID),
+			body: gin.H{
+				"phone_number": phoneNumber,
+				"role":         "staff",
+			},
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/api/stores/" + tc.uri + "/invitations"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestGetStoreInvitationList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	invitation := dto.GetStoreInvitationResponse{
+		ID:        util.CreateRandomInt64(1, 10),
+		StoreID:   util.CreateRandomInt64(1, 10),
+		Role:      repository.StoreInvitationRoleStaff,
+		InvitedBy: util.CreateRandomInt64(11, 20),
+		ExpiredAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					GetStoreInvitationList(gomock.Any(), gomock.Eq(service.GetStoreInvitationListParams{UserID: userID})).
+					Times(1).
+					Return(dto.GetStoreInvitationListResponse{List: []dto.GetStoreInvitationResponse{invitation}}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.NotEmpty(t, responseBody.Data)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					GetStoreInvitationList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(dto.GetStoreInvitationListResponse{}, err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/stores/invitations"
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestAcceptStoreInvitation(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	invitationID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		uri           string
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  fmt.Sprint(invitationID),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					AcceptStoreInvitation(gomock.Any(), gomock.Eq(service.AcceptStoreInvitationParams{
+						UserID:                           userID,
+						AcceptStoreInvitationRequestPath: dto.AcceptStoreInvitationRequestPath{ID: invitationID},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "int64 타입이 아닌 id",
+			uri:  util.CreateRandomString(5),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					AcceptStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  fmt.Sprint(invitationID),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					AcceptStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/stores/invitations/" + tc.uri + "/accept"
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
+
+func TestDeleteStoreMember(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	storeID := util.CreateRandomInt64(1, 10)
+	memberID := util.CreateRandomInt64(11, 20)
+
+	testCases := []struct {
+		name          string
+		uri           string
+		buildStubs    func(mockService *mockservice.MockService) service.CustomErr
+		checkResponse func(recorder *httptest.ResponseRecorder, errService service.CustomErr)
+	}{
+		{
+			name: "성공",
+			uri:  fmt.Sprintf("%d/members/%d", storeID, memberID),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.CustomErr{}
+
+				mockService.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Eq(service.DeleteStoreMemberParams{
+						UserID:                       userID,
+						DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: storeID, UserID: memberID},
+					})).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusOK)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Code, http.StatusOK)
+				require.Nil(t, responseBody.Data)
+			},
+		},
+		{
+			name: "int64 타입이 아닌 회원 id",
+			uri:  fmt.Sprintf("%d/members/%s", storeID, util.CreateRandomString(5)),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				mockService.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return service.CustomErr{}
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "Internal Service Error",
+			uri:  fmt.Sprintf("%d/members/%d", storeID, memberID),
+			buildStubs: func(mockService *mockservice.MockService) service.CustomErr {
+				err := service.NewErrInternalServer(sql.ErrConnDone)
+
+				mockService.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(err)
+
+				return err
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, errService service.CustomErr) {
+				require.Equal(t, recorder.Code, errService.Code)
+				responseBody := getResponseBody(t, recorder.Body)
+				require.Equal(t, responseBody.Meta.Message, errService.Err.Error())
+				require.Nil(t, responseBody.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockservice.NewMockService(ctrl)
+			controller := newTestController(t, service)
+
+			errService := tc.buildStubs(service)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/api/stores/" + tc.uri
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			AddAuthorization(t, request, middleware.AuthorizationTypeBearer, userID, testTokenMaker, testConfig.AccessTokenDuration)
+			controller.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, errService)
+		})
+	}
+}
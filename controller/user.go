@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/middleware"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/token"
+)
+
+func (controller *Controller) setUserRouter() {
+	// authorization
+	userRoutes := controller.router.Group("/api/users").Use(middleware.AuthMiddleware(controller.tokenMaker, controller.revocationStore, nil))
+
+	// 내 정보 조회 api
+	userRoutes.GET("/me", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		params := service.GetUserProfileParams{
+			UserID: authPayload.UserID,
+		}
+
+		// 내 정보 조회
+		result, cErr := controller.service.GetUserProfile(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 내 정보 수정 api
+	userRoutes.PATCH("/me", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.UpdateUserProfileRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.UpdateUserProfileParams{
+			UserID:                       authPayload.UserID,
+			UpdateUserProfileRequestBody: reqBody,
+		}
+
+		// 내 정보 수정
+		result, cErr := controller.service.UpdateUserProfile(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, result)
+	})
+
+	// 회원 탈퇴 api
+	userRoutes.DELETE("/me", func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(middleware.AuthorizationPayloadKey).(*token.Payload)
+
+		var reqBody dto.WithdrawUserRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.WithdrawUserParams{
+			UserID:                  authPayload.UserID,
+			WithdrawUserRequestBody: reqBody,
+		}
+
+		// 회원 탈퇴
+		cErr := controller.service.WithdrawUser(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+
+	// 회원 역할 변경 api (관리자 전용)
+	userRoutes.PATCH("/:id/role", middleware.RequireRole(repository.UserRoleAdmin), func(ctx *gin.Context) {
+		var reqPath dto.UpdateUserRoleRequestPath
+		// req path dto 검증
+		if err := ctx.ShouldBindUri(&reqPath); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqPath, "uri")
+			return
+		}
+
+		var reqBody dto.UpdateUserRoleRequestBody
+		// req body dto 검증
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			response.NewErrBindingResponse(ctx, err, &reqBody, "json")
+			return
+		}
+
+		params := service.UpdateUserRoleParams{
+			UpdateUserRoleRequestPath: reqPath,
+			UpdateUserRoleRequestBody: reqBody,
+		}
+
+		// 회원 역할 변경
+		cErr := controller.service.UpdateUserRole(ctx, params)
+		if cErr.Err != nil {
+			response.NewErrResponse(ctx, cErr)
+			return
+		}
+
+		response.NewOkResponse(ctx, nil)
+	})
+}
@@ -2,25 +2,81 @@ CREATE TABLE `user` (
   `id` bigint PRIMARY KEY AUTO_INCREMENT,
   `phone_number` char(11) UNIQUE NOT NULL,
   `hashed_password` varchar(255) NOT NULL,
-  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+  `role` enum('owner', 'staff', 'admin') NOT NULL DEFAULT 'owner',
+  `display_name` varchar(50) DEFAULT NULL,
+  `shop_name` varchar(100) DEFAULT NULL,
+  `timezone` varchar(50) NOT NULL DEFAULT 'Asia/Seoul',
+  `totp_secret` varchar(64) DEFAULT NULL,
+  `is_totp_enabled` tinyint(1) NOT NULL DEFAULT 0,
+  `totp_last_step` bigint NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `deleted_at` timestamp DEFAULT NULL
 );
 
+CREATE INDEX `user_deleted_at_idx` ON `user` (`deleted_at`);
+
 CREATE TABLE `session` (
   `id` varchar(36) PRIMARY KEY,
   `user_id` bigint NOT NULL,
+  `family_id` varchar(36) NOT NULL,
   `refresh_token` varchar(285) NOT NULL,
   `user_agent` varchar(255) NOT NULL,
   `client_ip` varchar(45) NOT NULL,
   `is_blocked` tinyint(1) NOT NULL DEFAULT 0,
+  `is_rotated` tinyint(1) NOT NULL DEFAULT 0,
+  `blocked_at` timestamp DEFAULT NULL,
   `expired_at` timestamp NOT NULL,
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE INDEX `session_family_id_idx` ON `session` (`family_id`);
+
+CREATE INDEX `session_expired_at_idx` ON `session` (`expired_at`);
+
+CREATE INDEX `session_blocked_at_idx` ON `session` (`blocked_at`);
+
 ALTER TABLE `session` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
 
-CREATE TABLE `product` (
+CREATE TABLE `store` (
   `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE `store_member` (
+  `store_id` bigint NOT NULL,
   `user_id` bigint NOT NULL,
+  `role` enum('owner', 'staff') NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`store_id`, `user_id`)
+);
+
+CREATE INDEX `store_member_user_id_idx` ON `store_member` (`user_id`);
+
+ALTER TABLE `store_member` ADD FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `store_member` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `store_invitation` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `store_id` bigint NOT NULL,
+  `phone_number` char(11) NOT NULL,
+  `role` enum('owner', 'staff') NOT NULL,
+  `invited_by` bigint NOT NULL,
+  `accepted_at` timestamp NULL DEFAULT NULL,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `store_invitation_phone_number_idx` ON `store_invitation` (`phone_number`);
+
+ALTER TABLE `store_invitation` ADD FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `store_invitation` ADD FOREIGN KEY (`invited_by`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `product` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `store_id` bigint NOT NULL,
   `category` varchar(100) NOT NULL,
   `price` int(10) NOT NULL,
   `cost` int(10) NOT NULL,
@@ -33,7 +89,84 @@ CREATE TABLE `product` (
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP 
 );
 
-ALTER TABLE `product` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+CREATE INDEX `product_store_id_created_at_id_idx` ON `product` (`store_id`, `created_at`, `id`);
+
+ALTER TABLE `product` ADD FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `verification` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `phone_number` char(11) NOT NULL,
+  `purpose` enum('register', 'password_reset', 'change_phone_number') NOT NULL,
+  `hashed_code` varchar(255) NOT NULL,
+  `attempt_count` int NOT NULL DEFAULT 0,
+  `is_used` tinyint(1) NOT NULL DEFAULT 0,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `verification_phone_number_purpose_idx` ON `verification` (`phone_number`, `purpose`);
+
+CREATE TABLE `recovery_code` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `hashed_code` varchar(255) NOT NULL,
+  `is_used` tinyint(1) NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE `recovery_code` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `api_key` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `name` varchar(100) NOT NULL,
+  `prefix` char(8) UNIQUE NOT NULL,
+  `hashed_key` char(64) NOT NULL,
+  `scopes` varchar(255) NOT NULL,
+  `expired_at` timestamp DEFAULT NULL,
+  `last_used_at` timestamp DEFAULT NULL,
+  `revoked_at` timestamp DEFAULT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `api_key_user_id_idx` ON `api_key` (`user_id`);
+
+ALTER TABLE `api_key` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `known_device` (
+  `user_id` bigint NOT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `client_ip` varchar(45) NOT NULL,
+  `last_seen_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`, `user_agent`, `client_ip`)
+);
+
+ALTER TABLE `known_device` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `revoked_token` (
+  `id` varchar(36) PRIMARY KEY,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `revoked_token_expired_at_idx` ON `revoked_token` (`expired_at`);
+
+CREATE TABLE `auth_event` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint DEFAULT NULL,
+  `phone_number` varchar(11) DEFAULT NULL,
+  `type` enum('register', 'login', 'login_two_factor', 'renew_access_token', 'logout', 'change_password', 'new_device_login', 'reset_password') NOT NULL,
+  `outcome` enum('success', 'failure') NOT NULL,
+  `reason` varchar(255) DEFAULT NULL,
+  `client_ip` varchar(45) NOT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `auth_event_user_id_created_at_idx` ON `auth_event` (`user_id`, `created_at`);
+
+ALTER TABLE `auth_event` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
 
 -- CREATE FUNCTION ExtractChosung(input_string varchar(100)) RETURNS varchar(100)
 -- DETERMINISTIC
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+)
+
+// API 키 권한 범위
+const (
+	ApiKeyScopeProductsRead  = "products:read"
+	ApiKeyScopeProductsWrite = "products:write"
+)
+
+type CreateApiKeyRequestBody struct {
+	Name          string   `json:"name" binding:"required,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,oneof=products:read products:write"`
+	ExpiresInDays int32    `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+}
+
+type CreateApiKeyResponse struct {
+	ID int64 `json:"id"`
+	// 발급 시에만 전달되며 다시 조회할 수 없음
+	Key       string     `json:"key"`
+	Prefix    string     `json:"prefix"`
+	Scopes    []string   `json:"scopes"`
+	ExpiredAt *time.Time `json:"expired_at"`
+}
+
+type GetApiKeyListResponse struct {
+	List []GetApiKeyResponse `json:"list"`
+}
+
+func NewGetApiKeyListResponse(apiKeyList []repository.ApiKey) GetApiKeyListResponse {
+	res := GetApiKeyListResponse{}
+
+	for _, apiKey := range apiKeyList {
+		res.List = append(res.List, NewGetApiKeyResponse(apiKey))
+	}
+
+	return res
+}
+
+type GetApiKeyResponse struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiredAt  *time.Time `json:"expired_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func NewGetApiKeyResponse(apiKey repository.ApiKey) GetApiKeyResponse {
+	res := GetApiKeyResponse{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    strings.Split(apiKey.Scopes, ","),
+		CreatedAt: apiKey.CreatedAt,
+	}
+	if apiKey.ExpiredAt.Valid {
+		res.ExpiredAt = &apiKey.ExpiredAt.Time
+	}
+	if apiKey.LastUsedAt.Valid {
+		res.LastUsedAt = &apiKey.LastUsedAt.Time
+	}
+
+	return res
+}
+
+type RevokeApiKeyRequestPath struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
@@ -1,15 +1,25 @@
 package dto
 
 type RegisterRequestBody struct {
+	PhoneNumber      string `json:"phone_number" binding:"required,phone_number"`
+	Password         string `json:"password" binding:"required,password"`
+	VerificationCode string `json:"verification_code" binding:"required,verification_code"`
+}
+
+// 정책 변경 전 가입한 회원도 로그인할 수 있도록 비밀번호 정책은 검증하지 않음
+type LoginRequestBody struct {
 	PhoneNumber string `json:"phone_number" binding:"required,phone_number"`
 	Password    string `json:"password" binding:"required"`
 }
 
-type LoginRequestBody = RegisterRequestBody
-
 type LoginResponseBody struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	// 2단계 인증을 사용하는 경우 토큰 대신 인증 대기 토큰 반환
+	TwoFactorRequired bool   `json:"two_factor_required,omitempty"`
+	TwoFactorToken    string `json:"two_factor_token,omitempty"`
+	// 동시 세션 수 제한으로 로그아웃된 세션
+	EvictedSessionList []GetSessionResponse `json:"evicted_sessions,omitempty"`
 }
 
 type RenewAccessTokenRequestBody struct {
@@ -17,3 +27,43 @@ type RenewAccessTokenRequestBody struct {
 }
 
 type RenewAccessTokenResponse = LoginResponseBody
+
+type LogoutRequestBody = RenewAccessTokenRequestBody
+
+type ChangePasswordRequestBody struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,password"`
+}
+
+type SendVerificationCodeRequestBody struct {
+	PhoneNumber string `json:"phone_number" binding:"required,phone_number"`
+	Purpose     string `json:"purpose" binding:"required,oneof=register password_reset change_phone_number"`
+}
+
+type ResetPasswordRequestBody struct {
+	PhoneNumber      string `json:"phone_number" binding:"required,phone_number"`
+	VerificationCode string `json:"verification_code" binding:"required,verification_code"`
+	NewPassword      string `json:"new_password" binding:"required,password"`
+}
+
+type EnrollTwoFactorRequestBody struct {
+	Password string `json:"password" binding:"required"`
+}
+
+type EnrollTwoFactorResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+	// base64 인코딩된 QR 코드 PNG 이미지
+	QRCode        string   `json:"qr_code"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type ConfirmTwoFactorRequestBody struct {
+	Code string `json:"code" binding:"required,verification_code"`
+}
+
+type LoginTwoFactorRequestBody struct {
+	TwoFactorToken string `json:"two_factor_token" binding:"required"`
+	Code           string `json:"code" binding:"required_without=RecoveryCode,omitempty,verification_code"`
+	RecoveryCode   string `json:"recovery_code" binding:"required_without=Code"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+)
+
+type GetAuthEventListRequestQuery struct {
+	Page int32 `form:"page" binding:"required,gte=1"`
+}
+
+type GetAuthEventListResponse struct {
+	List []GetAuthEventResponse `json:"list"`
+}
+
+func NewGetAuthEventListResponse(authEventList []repository.AuthEvent) GetAuthEventListResponse {
+	res := GetAuthEventListResponse{}
+
+	for _, authEvent := range authEventList {
+		res.List = append(res.List, NewGetAuthEventResponse(authEvent))
+	}
+
+	return res
+}
+
+type GetAuthEventResponse struct {
+	ID          int64     `json:"id"`
+	Type        string    `json:"type"`
+	Outcome     string    `json:"outcome"`
+	Reason      *string   `json:"reason"`
+	PhoneNumber *string   `json:"phone_number"`
+	ClientIp    string    `json:"client_ip"`
+	UserAgent   string    `json:"user_agent"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewGetAuthEventResponse(authEvent repository.AuthEvent) GetAuthEventResponse {
+	res := GetAuthEventResponse{
+		ID:        authEvent.ID,
+		Type:      string(authEvent.Type),
+		Outcome:   string(authEvent.Outcome),
+		ClientIp:  authEvent.ClientIp,
+		UserAgent: authEvent.UserAgent,
+		CreatedAt: authEvent.CreatedAt,
+	}
+	if authEvent.Reason.Valid {
+		res.Reason = &authEvent.Reason.String
+	}
+	if authEvent.PhoneNumber.Valid {
+		res.PhoneNumber = &authEvent.PhoneNumber.String
+	}
+
+	return res
+}
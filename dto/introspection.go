@@ -0,0 +1,20 @@
+package dto
+
+// RFC 7662 형식 토큰 검사 요청
+type IntrospectTokenRequestBody struct {
+	Token         string `form:"token" json:"token" binding:"required"`
+	TokenTypeHint string `form:"token_type_hint" json:"token_type_hint" binding:"omitempty,oneof=access_token refresh_token"`
+}
+
+// RFC 7662 형식 토큰 검사 결과
+// 유효하지 않은 토큰은 active 외 정보를 반환하지 않음 (세션 상태 제외)
+type IntrospectTokenResponse struct {
+	Active        bool   `json:"active"`
+	TokenType     string `json:"token_type,omitempty"`
+	UserID        int64  `json:"user_id,omitempty"`
+	Role          string `json:"role,omitempty"`
+	SessionID     string `json:"session_id,omitempty"`
+	SessionStatus string `json:"session_status,omitempty"`
+	IssuedAt      int64  `json:"iat,omitempty"`
+	ExpiredAt     int64  `json:"exp,omitempty"`
+}
@@ -18,13 +18,35 @@ type CreateProductRequestBody struct {
 	Size           string `json:"size" binding:"required,product_size"`
 }
 
+// cursor가 있으면 커서 기반으로 조회하고 page는 무시
+// 깊은 페이지는 offset 조회 비용이 크므로 page 최댓값 제한 (이후는 cursor로 조회)
+// 필터는 전달된 조건만 적용 (size는 조회 개수이므로 상품 사이즈는 product_size로 전달)
 type GetProductListRequestQuery struct {
-	Page    int32  `form:"page" binding:"required,gte=1"`
-	Keyword string `form:"keyword" biding:"omitempty"`
+	Page               int32  `form:"page" binding:"required_without=Cursor,omitempty,gte=1,max=10000"`
+	Cursor             string `form:"cursor" binding:"omitempty"`
+	Size               int32  `form:"size" binding:"omitempty,gte=1"`
+	Keyword            string `form:"keyword" biding:"omitempty"`
+	Category           string `form:"category" binding:"omitempty,max=100"`
+	ProductSize        string `form:"product_size" binding:"omitempty,product_size"`
+	MinPrice           *int32 `form:"min_price" binding:"omitempty,gte=0"`
+	MaxPrice           *int32 `form:"max_price" binding:"omitempty,gte=0"`
+	MinCost            *int32 `form:"min_cost" binding:"omitempty,gte=0"`
+	MaxCost            *int32 `form:"max_cost" binding:"omitempty,gte=0"`
+	ExpirationDateFrom string `form:"expiration_date_from" binding:"omitempty,date"`
+	ExpirationDateTo   string `form:"expiration_date_to" binding:"omitempty,date"`
+	// 오늘부터 n일 이내 유통기한 만료 (0이면 오늘 만료)
+	ExpiringWithinDays *int32 `form:"expiring_within_days" binding:"omitempty,gte=0"`
+	CreatedDateFrom    string `form:"created_date_from" binding:"omitempty,date"`
+	CreatedDateTo      string `form:"created_date_to" binding:"omitempty,date"`
 }
 
 type GetProductListResponse struct {
-	List []GetProductResponse `json:"list"`
+	List       []GetProductResponse `json:"list"`
+	TotalCount int64                `json:"total_count"`
+	TotalPages int64                `json:"total_pages"`
+	HasNext    bool                 `json:"has_next"`
+	// 다음 목록 조회 커서, 마지막 목록인 경우 빈 문자열
+	NextCursor string `json:"next_cursor"`
 }
 
 func NewGetProductListResponse(productList []repository.Product) GetProductListResponse {
@@ -43,7 +65,7 @@ type GetProductRequestPath struct {
 
 type GetProductResponse struct {
 	ID             int64                  `json:"id"`
-	UserID         int64                  `json:"user_id"`
+	StoreID        int64                  `json:"store_id"`
 	Category       string                 `json:"category"`
 	Price          int32                  `json:"price"`
 	Cost           int32                  `json:"cost"`
@@ -59,7 +81,7 @@ type GetProductResponse struct {
 func NewGetProductResponse(product repository.Product) GetProductResponse {
 	return GetProductResponse{
 		ID:             product.ID,
-		UserID:         product.UserID,
+		StoreID:        product.StoreID,
 		Category:       product.Category,
 		Price:          product.Price,
 		Cost:           product.Cost,
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/device"
+)
+
+type GetSessionListResponse struct {
+	List []GetSessionResponse `json:"list"`
+}
+
+func NewGetSessionListResponse(sessionList []repository.Session, currentSessionID string) GetSessionListResponse {
+	res := GetSessionListResponse{}
+
+	for _, session := range sessionList {
+		res.List = append(res.List, NewGetSessionResponse(session, currentSessionID))
+	}
+
+	return res
+}
+
+type GetSessionResponse struct {
+	ID        string        `json:"id"`
+	Device    device.Device `json:"device"`
+	UserAgent string        `json:"user_agent"`
+	ClientIp  string        `json:"client_ip"`
+	IsCurrent bool          `json:"is_current"`
+	CreatedAt time.Time     `json:"created_at"`
+	ExpiredAt time.Time     `json:"expired_at"`
+}
+
+func NewGetSessionResponse(session repository.Session, currentSessionID string) GetSessionResponse {
+	return GetSessionResponse{
+		ID:        session.ID,
+		Device:    device.Parse(session.UserAgent),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		IsCurrent: session.ID == currentSessionID,
+		CreatedAt: session.CreatedAt,
+		ExpiredAt: session.ExpiredAt,
+	}
+}
+
+type DeleteSessionRequestPath struct {
+	ID string `uri:"id" binding:"required"`
+}
+
+type DeleteSessionListRequestQuery struct {
+	Except string `form:"except" binding:"omitempty,oneof=current"`
+}
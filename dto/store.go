@@ -0,0 +1,94 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+)
+
+// 요청 매장 지정 헤더 (미입력 시 기본 매장 사용)
+type StoreRequestHeader struct {
+	StoreID int64 `header:"X-Store-ID" binding:"omitempty,min=1"`
+}
+
+type CreateStoreRequestBody struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type CreateStoreResponse struct {
+	ID int64 `json:"id"`
+}
+
+type GetStoreListResponse struct {
+	List []GetStoreResponse `json:"list"`
+}
+
+func NewGetStoreListResponse(storeList []repository.GetStoreListRow) GetStoreListResponse {
+	res := GetStoreListResponse{}
+
+	for _, store := range storeList {
+		res.List = append(res.List, GetStoreResponse{
+			ID:        store.ID,
+			Name:      store.Name,
+			Role:      store.Role,
+			CreatedAt: store.CreatedAt,
+		})
+	}
+
+	return res
+}
+
+type GetStoreResponse struct {
+	ID        int64                      `json:"id"`
+	Name      string                     `json:"name"`
+	Role      repository.StoreMemberRole `json:"role"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+type CreateStoreInvitationRequestPath struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type CreateStoreInvitationRequestBody struct {
+	PhoneNumber string `json:"phone_number" binding:"required,phone_number"`
+	Role        string `json:"role" binding:"required,oneof=owner staff"`
+}
+
+type GetStoreInvitationListResponse struct {
+	List []GetStoreInvitationResponse `json:"list"`
+}
+
+func NewGetStoreInvitationListResponse(invitationList []repository.StoreInvitation) GetStoreInvitationListResponse {
+	res := GetStoreInvitationListResponse{}
+
+	for _, invitation := range invitationList {
+		res.List = append(res.List, GetStoreInvitationResponse{
+			ID:        invitation.ID,
+			StoreID:   invitation.StoreID,
+			Role:      invitation.Role,
+			InvitedBy: invitation.InvitedBy,
+			ExpiredAt: invitation.ExpiredAt,
+			CreatedAt: invitation.CreatedAt,
+		})
+	}
+
+	return res
+}
+
+type GetStoreInvitationResponse struct {
+	ID        int64                          `json:"id"`
+	StoreID   int64                          `json:"store_id"`
+	Role      repository.StoreInvitationRole `json:"role"`
+	InvitedBy int64                          `json:"invited_by"`
+	ExpiredAt time.Time                      `json:"expired_at"`
+	CreatedAt time.Time                      `json:"created_at"`
+}
+
+type AcceptStoreInvitationRequestPath struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type DeleteStoreMemberRequestPath struct {
+	ID     int64 `uri:"id" binding:"required,min=1"`
+	UserID int64 `uri:"user_id" binding:"required,min=1"`
+}
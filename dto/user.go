@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+)
+
+type GetUserProfileResponse struct {
+	ID            int64     `json:"id"`
+	PhoneNumber   string    `json:"phone_number"`
+	Role          string    `json:"role"`
+	DisplayName   *string   `json:"display_name"`
+	ShopName      *string   `json:"shop_name"`
+	Timezone      string    `json:"timezone"`
+	IsTotpEnabled bool      `json:"is_totp_enabled"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// 비밀번호, 2단계 인증 secret은 응답에서 제외
+func NewGetUserProfileResponse(user repository.User) GetUserProfileResponse {
+	res := GetUserProfileResponse{
+		ID:            user.ID,
+		PhoneNumber:   user.PhoneNumber,
+		Role:          string(user.Role),
+		Timezone:      user.Timezone,
+		IsTotpEnabled: user.IsTotpEnabled,
+		CreatedAt:     user.CreatedAt,
+	}
+	if user.DisplayName.Valid {
+		res.DisplayName = &user.DisplayName.String
+	}
+	if user.ShopName.Valid {
+		res.ShopName = &user.ShopName.String
+	}
+
+	return res
+}
+
+// 빈 문자열을 입력한 경우 표시 이름, 매장 이름 삭제
+// 휴대폰 번호 변경 시 새 휴대폰 번호로 발송한 인증번호 필요
+type UpdateUserProfileRequestBody struct {
+	DisplayName      *string `json:"display_name" binding:"omitempty,max=50"`
+	ShopName         *string `json:"shop_name" binding:"omitempty,max=100"`
+	Timezone         *string `json:"timezone" binding:"omitempty,timezone"`
+	PhoneNumber      *string `json:"phone_number" binding:"omitempty,phone_number"`
+	VerificationCode *string `json:"verification_code" binding:"required_with=PhoneNumber,omitempty,verification_code"`
+}
+
+type WithdrawUserRequestBody struct {
+	Password string `json:"password" binding:"required"`
+}
+
+type UpdateUserRoleRequestPath struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type UpdateUserRoleRequestBody struct {
+	Role string `json:"role" binding:"required,oneof=owner staff admin"`
+}
@@ -0,0 +1,46 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/scheduler"
+	"github.com/rs/zerolog/log"
+)
+
+// 주기 작업 등록
+func registerJobs(config util.Config, scheduler *scheduler.Scheduler, service service.Service, revocationStore revocation.Store) {
+	// 유예 기간이 지난 탈퇴 회원 삭제
+	scheduler.Register("purge_withdrawn_users", config.UserPurgeInterval, func(ctx context.Context) error {
+		count, cErr := service.PurgeWithdrawnUsers(ctx)
+		if cErr.Err != nil {
+			return cErr.Err
+		}
+
+		log.Info().Int64("count", count).Msg("withdrawn users purged")
+		return nil
+	})
+
+	// 만료되었거나 오래 차단된 세션 삭제
+	scheduler.Register("purge_sessions", config.SessionPurgeInterval, func(ctx context.Context) error {
+		count, cErr := service.PurgeSessions(ctx)
+		if cErr.Err != nil {
+			return cErr.Err
+		}
+
+		log.Info().Int64("count", count).Msg("sessions purged")
+		return nil
+	})
+	// 만료된 폐기 토큰 기록 삭제
+	scheduler.Register("purge_revoked_tokens", config.RevokedTokenPurgeInterval, func(ctx context.Context) error {
+		count, err := revocationStore.Purge(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Info().Int64("count", count).Msg("revoked tokens purged")
+		return nil
+	})
+}
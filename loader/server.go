@@ -1,32 +1,102 @@
 package loader
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"net/http"
 
 	"github.com/gitaepark/pha/controller"
 	"github.com/gitaepark/pha/repository"
 	"github.com/gitaepark/pha/service"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
+	"github.com/gitaepark/pha/util/hasher"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/scheduler"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/gitaepark/pha/util/token"
 )
 
 type Server struct {
 	config     util.Config
 	controller *controller.Controller
+	scheduler  *scheduler.Scheduler
+	httpServer *http.Server
 }
 
 func NewServer(config util.Config, conn *sql.DB) (*Server, error) {
-	repository := repository.New(conn)
-	service := service.NewService(config, repository)
-	controller := controller.NewController(config, service)
+	tokenMaker, err := token.NewTokenMaker(config)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHasher, err := hasher.NewPasswordHasher(config)
+	if err != nil {
+		return nil, err
+	}
+
+	smsSender, err := sms.NewSMSSender(config)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := notifier.NewNotifier(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorSigner, err := cursor.NewSigner(config.CursorSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := repository.NewRepository(conn)
+
+	revocationStore, err := revocation.NewStore(config, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	service := service.NewService(config, tokenMaker, passwordHasher, lockout.NewMemoryStore(), revocationStore, smsSender, notifier, cursorSigner, repository)
+	controller := controller.NewController(config, tokenMaker, revocationStore, service)
+
+	scheduler := scheduler.NewScheduler()
+	registerJobs(config, scheduler, service, revocationStore)
 
 	server := &Server{
 		config:     config,
 		controller: controller,
+		scheduler:  scheduler,
+		httpServer: &http.Server{
+			Addr:    config.HTTPServerAddress,
+			Handler: controller.Handler(),
+		},
 	}
 
 	return server, nil
 }
 
-func (server *Server) Start(address string) error {
-	return server.controller.Run(address)
+// 서버 시작 함수
+// Shutdown으로 종료된 경우 nil 반환
+func (server *Server) Start() error {
+	server.scheduler.Start()
+
+	err := server.httpServer.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// 서버 종료 함수
+// 처리 중인 요청과 실행 중인 주기 작업이 끝날 때까지 ctx 기한 내에서 대기
+func (server *Server) Shutdown(ctx context.Context) error {
+	httpErr := server.httpServer.Shutdown(ctx)
+	schedulerErr := server.scheduler.Stop(ctx)
+
+	return errors.Join(httpErr, schedulerErr)
 }
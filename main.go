@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -32,11 +35,28 @@ func main() {
 func runServer(config util.Config, conn *sql.DB) {
 	server, err := loader.NewServer(config, conn)
 	if err != nil {
-		log.Fatal().Msg("cannot create server")
+		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	err = server.Start(config.HTTPServerAddress)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		err := server.Start()
+		if err != nil {
+			log.Fatal().Msg("cannot start server")
+		}
+	}()
+
+	// 종료 신호 대기
+	<-ctx.Done()
+	log.Info().Msg("shutting down server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+
+	err = server.Shutdown(shutdownCtx)
 	if err != nil {
-		log.Fatal().Msg("cannot start server")
+		log.Error().Err(err).Msg("failed to shutdown server gracefully")
 	}
 }
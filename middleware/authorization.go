@@ -1,21 +1,31 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gitaepark/pha/controller/response"
-	"github.com/gitaepark/pha/util"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/service"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/token"
 )
 
 const (
 	AuthorizationHeaderKey  = "authorization"
 	AuthorizationTypeBearer = "bearer"
+	AuthorizationTypeApiKey = "apikey"
 	AuthorizationPayloadKey = "user"
 )
 
-func AuthMiddleware(config util.Config) gin.HandlerFunc {
+// API 키 검증 인터페이스
+type ApiKeyVerifier interface {
+	VerifyApiKey(ctx context.Context, key string) (payload *token.Payload, cErr service.CustomErr)
+}
+
+// revocationStore에 폐기된 토큰은 만료 전이라도 거부
+// apiKeyVerifier가 nil이면 bearer 토큰만 허용
+func AuthMiddleware(tokenMaker token.TokenMaker, revocationStore revocation.Store, apiKeyVerifier ApiKeyVerifier) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(AuthorizationHeaderKey)
 
@@ -33,6 +43,18 @@ func AuthMiddleware(config util.Config) gin.HandlerFunc {
 		}
 
 		authorizationType := strings.ToLower(fields[0])
+		// API 키 인증
+		if authorizationType == AuthorizationTypeApiKey && apiKeyVerifier != nil {
+			payload, cErr := apiKeyVerifier.VerifyApiKey(ctx, fields[1])
+			if cErr.Err != nil {
+				response.NewErrResponse(ctx, cErr)
+				return
+			}
+
+			ctx.Set(AuthorizationPayloadKey, payload)
+			ctx.Next()
+			return
+		}
 		// authorization header bearer 타입 검증
 		if authorizationType != AuthorizationTypeBearer {
 			response.NewErrResponse(ctx, errInvalidAuthorizationBearer)
@@ -41,11 +63,26 @@ func AuthMiddleware(config util.Config) gin.HandlerFunc {
 
 		accessToken := fields[1]
 		// 토큰 검증
-		payload, err := jwt.VerifyToken(accessToken, config.JWTSecret)
+		payload, err := tokenMaker.VerifyToken(accessToken)
 		if err != nil {
 			response.NewErrResponse(ctx, errToken(err))
 			return
 		}
+		// refresh 토큰, 2단계 인증 대기 토큰 등 access 토큰이 아닌 경우
+		if payload.Kind != token.KindAccess || payload.Purpose != "" {
+			response.NewErrResponse(ctx, errToken(token.ErrInvalidToken))
+			return
+		}
+		// 폐기된 토큰 검증
+		revoked, err := revocation.IsPayloadRevoked(ctx, revocationStore, payload)
+		if err != nil {
+			response.NewErrResponse(ctx, service.NewErrInternalServer(err))
+			return
+		}
+		if revoked {
+			response.NewErrResponse(ctx, errRevokedToken)
+			return
+		}
 
 		ctx.Set(AuthorizationPayloadKey, payload)
 		ctx.Next()
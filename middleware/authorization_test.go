@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -8,7 +9,12 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/service"
+	mockservice "github.com/gitaepark/pha/service/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -53,6 +59,57 @@ func TestAuthMiddleware(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name: "PurposeToken",
+			setupAuth: func(request *http.Request) {
+				token, _, err := testTokenMaker.CreatePurposeToken(1, token.PurposeTwoFactor, testConfig.AccessTokenDuration)
+				require.NoError(t, err)
+
+				request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("%s %s", AuthorizationTypeBearer, token))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "RefreshToken",
+			setupAuth: func(request *http.Request) {
+				token, _, err := testTokenMaker.CreateRefreshToken(1, "", testConfig.AccessTokenDuration)
+				require.NoError(t, err)
+
+				request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("%s %s", AuthorizationTypeBearer, token))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "RevokedToken",
+			setupAuth: func(request *http.Request) {
+				token, payload, err := testTokenMaker.CreateToken(1, "", "", testConfig.AccessTokenDuration)
+				require.NoError(t, err)
+				require.NoError(t, testRevocationStore.Revoke(context.Background(), payload.ID, payload.ExpiredAt))
+
+				request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("%s %s", AuthorizationTypeBearer, token))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "RevokedSession",
+			setupAuth: func(request *http.Request) {
+				sessionID := uuid.NewString()
+				token, payload, err := testTokenMaker.CreateToken(1, "", sessionID, testConfig.AccessTokenDuration)
+				require.NoError(t, err)
+				require.NoError(t, testRevocationStore.Revoke(context.Background(), sessionID, payload.ExpiredAt))
+
+				request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("%s %s", AuthorizationTypeBearer, token))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 		{
 			name: "ExpiredToken",
 			setupAuth: func(request *http.Request) {
@@ -75,7 +132,7 @@ func TestAuthMiddleware(t *testing.T) {
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				AuthMiddleware(testConfig),
+				AuthMiddleware(testTokenMaker, testRevocationStore, nil),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -97,10 +154,88 @@ func addAuthorization(
 	authorizationType string,
 	userID int64,
 ) {
-	token, payload, err := jwt.CreateToken(userID, testConfig.JWTSecret, testConfig.AccessTokenDuration)
+	token, payload, err := testTokenMaker.CreateToken(userID, "", "", testConfig.AccessTokenDuration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
 	authorizationHeader := fmt.Sprintf("%s %s", authorizationType, token)
 	request.Header.Set(AuthorizationHeaderKey, authorizationHeader)
 }
+
+func TestAuthMiddlewareApiKey(t *testing.T) {
+	key := util.CreateRandomString(10)
+
+	testCases := []struct {
+		name           string
+		apiKeyVerifier func(ctrl *gomock.Controller) ApiKeyVerifier
+		checkResponse  func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			apiKeyVerifier: func(ctrl *gomock.Controller) ApiKeyVerifier {
+				mockService := mockservice.NewMockService(ctrl)
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Eq(key)).
+					Times(1).
+					Return(&token.Payload{UserID: 1, ApiKeyID: 1, Scopes: []string{"products:read"}}, service.CustomErr{})
+
+				return mockService
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidApiKey",
+			apiKeyVerifier: func(ctrl *gomock.Controller) ApiKeyVerifier {
+				mockService := mockservice.NewMockService(ctrl)
+				mockService.EXPECT().
+					VerifyApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid api key")})
+
+				return mockService
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "UnsupportedApiKey",
+			apiKeyVerifier: func(ctrl *gomock.Controller) ApiKeyVerifier {
+				return nil
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newServer()
+			recorder := httptest.NewRecorder()
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				AuthMiddleware(testTokenMaker, testRevocationStore, tc.apiKeyVerifier(ctrl)),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+			request, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("ApiKey %s", key))
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+)
+
+// 내부 서비스 인증 미들웨어 (HTTP Basic 인증)
+// clientSecret이 비어있으면 모든 요청 거부
+func ClientAuthMiddleware(clientID string, clientSecret string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, secret, ok := ctx.Request.BasicAuth()
+		if !ok {
+			response.NewErrResponse(ctx, errEmptyAuthorizationHeader)
+			return
+		}
+
+		if clientSecret == "" ||
+			subtle.ConstantTimeCompare([]byte(id), []byte(clientID)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(secret), []byte(clientSecret)) != 1 {
+			response.NewErrResponse(ctx, errInvalidClientCredentials)
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAuthMiddleware(t *testing.T) {
+	clientID := "pha-internal"
+	clientSecret := util.CreateRandomString(32)
+
+	testCases := []struct {
+		name          string
+		clientSecret  string
+		setupAuth     func(t *testing.T, request *http.Request)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "OK",
+			clientSecret: clientSecret,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(clientID, clientSecret)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:         "NoAuthorization",
+			clientSecret: clientSecret,
+			setupAuth: func(t *testing.T, request *http.Request) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:         "WrongClientID",
+			clientSecret: clientSecret,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(util.CreateRandomString(10), clientSecret)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:         "WrongClientSecret",
+			clientSecret: clientSecret,
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(clientID, util.CreateRandomString(32))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:         "EmptyClientSecret",
+			clientSecret: "",
+			setupAuth: func(t *testing.T, request *http.Request) {
+				request.SetBasicAuth(clientID, "")
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServer()
+			recorder := httptest.NewRecorder()
+
+			authPath := "/auth"
+			server.router.POST(
+				authPath,
+				ClientAuthMiddleware(clientID, tc.clientSecret),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+			request, err := http.NewRequest(http.MethodPost, authPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -11,6 +11,10 @@ var (
 	errEmptyAuthorizationHeader   = service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("authorization header is not provided")}
 	errInvalidAuthorizationHeader = service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid authorization header format")}
 	errInvalidAuthorizationBearer = service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("unsupported authorization type")}
+	errRevokedToken               = service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("token has been revoked")}
+	errForbiddenRole              = service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("permission denied")}
+	errForbiddenScope             = service.CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("insufficient api key scope")}
+	errInvalidClientCredentials   = service.CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid client credentials")}
 )
 
 func errToken(err error) service.CustomErr {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/token"
 )
 
 var testConfig = util.Config{
@@ -14,6 +16,10 @@ var testConfig = util.Config{
 	AccessTokenDuration: time.Minute,
 }
 
+var testTokenMaker, _ = token.NewTokenMaker(testConfig)
+
+var testRevocationStore = revocation.NewMemoryStore()
+
 type Server struct {
 	router *gin.Engine
 }
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/token"
+)
+
+// 허용된 역할만 접근 가능한 미들웨어
+// AuthMiddleware 이후에 사용
+func RequireRole(roles ...repository.UserRole) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload, ok := ctx.Get(AuthorizationPayloadKey)
+		if !ok {
+			response.NewErrResponse(ctx, errEmptyAuthorizationHeader)
+			return
+		}
+
+		role := payloadRole(authPayload.(*token.Payload))
+		for _, allowed := range roles {
+			if role == allowed {
+				ctx.Next()
+				return
+			}
+		}
+
+		response.NewErrResponse(ctx, errForbiddenRole)
+	}
+}
+
+// 토큰의 역할 조회 함수
+// 역할이 도입되기 전에 발급된 토큰은 owner로 취급
+func payloadRole(payload *token.Payload) repository.UserRole {
+	if payload.Role == "" {
+		return repository.UserRoleOwner
+	}
+
+	return repository.UserRole(payload.Role)
+}
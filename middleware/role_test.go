@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireRole(t *testing.T) {
+	testCases := []struct {
+		name          string
+		role          string
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			role: string(repository.UserRoleOwner),
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "EmptyRole",
+			role: "",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "ForbiddenRole",
+			role: string(repository.UserRoleStaff),
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServer()
+			recorder := httptest.NewRecorder()
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				AuthMiddleware(testTokenMaker, testRevocationStore, nil),
+				RequireRole(repository.UserRoleOwner, repository.UserRoleAdmin),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+			request, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			token, _, err := testTokenMaker.CreateToken(1, tc.role, "", time.Minute)
+			require.NoError(t, err)
+			request.Header.Set(AuthorizationHeaderKey, fmt.Sprintf("%s %s", AuthorizationTypeBearer, token))
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
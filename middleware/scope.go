@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/controller/response"
+	"github.com/gitaepark/pha/util/token"
+)
+
+// API 키 권한 범위 검증 미들웨어
+// bearer 토큰은 회원 본인의 요청이므로 권한 범위 제한 없음
+// AuthMiddleware 이후에 사용
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload, ok := ctx.Get(AuthorizationPayloadKey)
+		if !ok {
+			response.NewErrResponse(ctx, errEmptyAuthorizationHeader)
+			return
+		}
+
+		payload := authPayload.(*token.Payload)
+		if payload.ApiKeyID == 0 {
+			ctx.Next()
+			return
+		}
+
+		for _, allowed := range payload.Scopes {
+			if allowed == scope {
+				ctx.Next()
+				return
+			}
+		}
+
+		response.NewErrResponse(ctx, errForbiddenScope)
+	}
+}
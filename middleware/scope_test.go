@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireScope(t *testing.T) {
+	testCases := []struct {
+		name          string
+		payload       *token.Payload
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "OK",
+			payload: &token.Payload{UserID: 1, ApiKeyID: 1, Scopes: []string{"products:read", "products:write"}},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "BearerToken",
+			payload: &token.Payload{UserID: 1},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:    "ForbiddenScope",
+			payload: &token.Payload{UserID: 1, ApiKeyID: 1, Scopes: []string{"products:read"}},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:    "NoPayload",
+			payload: nil,
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServer()
+			recorder := httptest.NewRecorder()
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				func(ctx *gin.Context) {
+					if tc.payload != nil {
+						ctx.Set(AuthorizationPayloadKey, tc.payload)
+					}
+				},
+				RequireScope("products:write"),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+			request, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
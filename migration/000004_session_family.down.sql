@@ -0,0 +1,5 @@
+DROP INDEX `session_family_id_idx` ON `session`;
+
+ALTER TABLE `session` DROP COLUMN `is_rotated`;
+
+ALTER TABLE `session` DROP COLUMN `family_id`;
\ No newline at end of file
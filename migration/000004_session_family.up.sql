@@ -0,0 +1,7 @@
+ALTER TABLE `session` ADD `family_id` varchar(36) NOT NULL AFTER `user_id`;
+
+ALTER TABLE `session` ADD `is_rotated` tinyint(1) NOT NULL DEFAULT 0 AFTER `is_blocked`;
+
+UPDATE `session` SET `family_id` = `id`;
+
+CREATE INDEX `session_family_id_idx` ON `session` (`family_id`);
\ No newline at end of file
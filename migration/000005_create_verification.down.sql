@@ -0,0 +1 @@
+DROP TABLE `verification`;
\ No newline at end of file
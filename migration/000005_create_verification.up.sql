@@ -0,0 +1,12 @@
+CREATE TABLE `verification` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `phone_number` char(11) NOT NULL,
+  `purpose` enum('register', 'password_reset') NOT NULL,
+  `hashed_code` varchar(255) NOT NULL,
+  `attempt_count` int NOT NULL DEFAULT 0,
+  `is_used` tinyint(1) NOT NULL DEFAULT 0,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `verification_phone_number_purpose_idx` ON `verification` (`phone_number`, `purpose`);
\ No newline at end of file
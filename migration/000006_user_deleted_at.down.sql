@@ -0,0 +1,3 @@
+DROP INDEX `user_deleted_at_idx` ON `user`;
+
+ALTER TABLE `user` DROP COLUMN `deleted_at`;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE `user` ADD `deleted_at` timestamp NULL DEFAULT NULL AFTER `created_at`;
+
+CREATE INDEX `user_deleted_at_idx` ON `user` (`deleted_at`);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP TABLE `recovery_code`;
+
+ALTER TABLE `user` DROP COLUMN `is_totp_enabled`;
+
+ALTER TABLE `user` DROP COLUMN `totp_secret`;
\ No newline at end of file
@@ -0,0 +1,13 @@
+ALTER TABLE `user` ADD `totp_secret` varchar(64) DEFAULT NULL AFTER `hashed_password`;
+
+ALTER TABLE `user` ADD `is_totp_enabled` tinyint(1) NOT NULL DEFAULT 0 AFTER `totp_secret`;
+
+CREATE TABLE `recovery_code` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `hashed_code` varchar(255) NOT NULL,
+  `is_used` tinyint(1) NOT NULL DEFAULT 0,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE `recovery_code` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX `session_blocked_at_idx` ON `session`;
+
+DROP INDEX `session_expired_at_idx` ON `session`;
+
+ALTER TABLE `session` DROP COLUMN `blocked_at`;
\ No newline at end of file
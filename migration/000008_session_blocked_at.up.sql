@@ -0,0 +1,7 @@
+ALTER TABLE `session` ADD `blocked_at` timestamp NULL DEFAULT NULL AFTER `is_rotated`;
+
+UPDATE `session` SET `blocked_at` = NOW() WHERE `is_blocked` = true;
+
+CREATE INDEX `session_expired_at_idx` ON `session` (`expired_at`);
+
+CREATE INDEX `session_blocked_at_idx` ON `session` (`blocked_at`);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `user` ADD `role` enum('owner', 'staff', 'admin') NOT NULL DEFAULT 'owner' AFTER `hashed_password`;
\ No newline at end of file
@@ -0,0 +1,25 @@
+ALTER TABLE `product` ADD `user_id` bigint NULL AFTER `id`;
+
+UPDATE `product`
+SET `user_id` = (
+  SELECT MIN(`store_member`.`user_id`)
+  FROM `store_member`
+  WHERE `store_member`.`store_id` = `product`.`store_id`
+    AND `store_member`.`role` = 'owner'
+);
+
+DELETE FROM `product` WHERE `user_id` IS NULL;
+
+ALTER TABLE `product` MODIFY `user_id` bigint NOT NULL;
+
+ALTER TABLE `product` ADD CONSTRAINT `product_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `product` DROP FOREIGN KEY `product_store_id_fk`;
+
+ALTER TABLE `product` DROP COLUMN `store_id`;
+
+DROP TABLE `store_invitation`;
+
+DROP TABLE `store_member`;
+
+DROP TABLE `store`;
\ No newline at end of file
@@ -0,0 +1,56 @@
+CREATE TABLE `store` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE `store_member` (
+  `store_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `role` enum('owner', 'staff') NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`store_id`, `user_id`)
+);
+
+CREATE INDEX `store_member_user_id_idx` ON `store_member` (`user_id`);
+
+ALTER TABLE `store_member` ADD FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `store_member` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+CREATE TABLE `store_invitation` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `store_id` bigint NOT NULL,
+  `phone_number` char(11) NOT NULL,
+  `role` enum('owner', 'staff') NOT NULL,
+  `invited_by` bigint NOT NULL,
+  `accepted_at` timestamp NULL DEFAULT NULL,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `store_invitation_phone_number_idx` ON `store_invitation` (`phone_number`);
+
+ALTER TABLE `store_invitation` ADD FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `store_invitation` ADD FOREIGN KEY (`invited_by`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+-- 기존 회원마다 매장 생성 (매장 id는 회원 id 사용)
+INSERT INTO `store` (`id`, `name`, `created_at`)
+SELECT `id`, `phone_number`, `created_at` FROM `user`;
+
+INSERT INTO `store_member` (`store_id`, `user_id`, `role`, `created_at`)
+SELECT `id`, `id`, 'owner', `created_at` FROM `user`;
+
+-- 상품 소유를 회원에서 매장으로 이동
+ALTER TABLE `product` ADD `store_id` bigint NULL AFTER `id`;
+
+UPDATE `product` SET `store_id` = `user_id`;
+
+ALTER TABLE `product` MODIFY `store_id` bigint NOT NULL;
+
+ALTER TABLE `product` ADD CONSTRAINT `product_store_id_fk` FOREIGN KEY (`store_id`) REFERENCES `store` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `product` DROP FOREIGN KEY `product_ibfk_1`;
+
+ALTER TABLE `product` DROP COLUMN `user_id`;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE `api_key` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint NOT NULL,
+  `name` varchar(100) NOT NULL,
+  `prefix` char(8) UNIQUE NOT NULL,
+  `hashed_key` char(64) NOT NULL,
+  `scopes` varchar(255) NOT NULL,
+  `expired_at` timestamp DEFAULT NULL,
+  `last_used_at` timestamp DEFAULT NULL,
+  `revoked_at` timestamp DEFAULT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `api_key_user_id_idx` ON `api_key` (`user_id`);
+
+ALTER TABLE `api_key` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE `revoked_token` (
+  `id` varchar(36) PRIMARY KEY,
+  `expired_at` timestamp NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `revoked_token_expired_at_idx` ON `revoked_token` (`expired_at`);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE `auth_event`;
\ No newline at end of file
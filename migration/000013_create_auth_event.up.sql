@@ -0,0 +1,15 @@
+CREATE TABLE `auth_event` (
+  `id` bigint PRIMARY KEY AUTO_INCREMENT,
+  `user_id` bigint DEFAULT NULL,
+  `phone_number` varchar(11) DEFAULT NULL,
+  `type` enum('register', 'login', 'login_two_factor', 'renew_access_token', 'logout', 'change_password') NOT NULL,
+  `outcome` enum('success', 'failure') NOT NULL,
+  `reason` varchar(255) DEFAULT NULL,
+  `client_ip` varchar(45) NOT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `auth_event_user_id_created_at_idx` ON `auth_event` (`user_id`, `created_at`);
+
+ALTER TABLE `auth_event` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
\ No newline at end of file
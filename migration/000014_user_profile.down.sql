@@ -0,0 +1,7 @@
+ALTER TABLE `verification` MODIFY `purpose` enum('register', 'password_reset') NOT NULL;
+
+ALTER TABLE `user` DROP COLUMN `timezone`;
+
+ALTER TABLE `user` DROP COLUMN `shop_name`;
+
+ALTER TABLE `user` DROP COLUMN `display_name`;
\ No newline at end of file
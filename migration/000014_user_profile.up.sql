@@ -0,0 +1,7 @@
+ALTER TABLE `user` ADD `display_name` varchar(50) DEFAULT NULL AFTER `role`;
+
+ALTER TABLE `user` ADD `shop_name` varchar(100) DEFAULT NULL AFTER `display_name`;
+
+ALTER TABLE `user` ADD `timezone` varchar(50) NOT NULL DEFAULT 'Asia/Seoul' AFTER `shop_name`;
+
+ALTER TABLE `verification` MODIFY `purpose` enum('register', 'password_reset', 'change_phone_number') NOT NULL;
\ No newline at end of file
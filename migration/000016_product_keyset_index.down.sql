@@ -0,0 +1 @@
+DROP INDEX `product_store_id_created_at_id_idx` ON `product`;
\ No newline at end of file
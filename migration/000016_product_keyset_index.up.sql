@@ -0,0 +1 @@
+CREATE INDEX `product_store_id_created_at_id_idx` ON `product` (`store_id`, `created_at`, `id`);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `user` ADD `totp_last_step` bigint NOT NULL DEFAULT 0 AFTER `is_totp_enabled`;
\ No newline at end of file
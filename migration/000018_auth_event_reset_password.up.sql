@@ -0,0 +1 @@
+ALTER TABLE `auth_event` MODIFY `type` enum('register', 'login', 'login_two_factor', 'renew_access_token', 'logout', 'change_password', 'new_device_login', 'reset_password') NOT NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE `known_device`;
\ No newline at end of file
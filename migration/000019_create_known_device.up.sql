@@ -0,0 +1,15 @@
+CREATE TABLE `known_device` (
+  `user_id` bigint NOT NULL,
+  `user_agent` varchar(255) NOT NULL,
+  `client_ip` varchar(45) NOT NULL,
+  `last_seen_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`, `user_agent`, `client_ip`)
+);
+
+ALTER TABLE `known_device` ADD FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE CASCADE;
+
+INSERT INTO `known_device` (`user_id`, `user_agent`, `client_ip`, `last_seen_at`, `created_at`)
+SELECT `user_id`, `user_agent`, `client_ip`, MAX(`created_at`), MIN(`created_at`)
+FROM `session`
+GROUP BY `user_id`, `user_agent`, `client_ip`;
\ No newline at end of file
@@ -0,0 +1,37 @@
+-- name: CreateApiKey :execlastid
+INSERT INTO api_key(
+  user_id,
+  name,
+  prefix,
+  hashed_key,
+  scopes,
+  expired_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetApiKeyByPrefix :one
+SELECT
+  *
+FROM api_key
+WHERE prefix = ?;
+
+-- name: GetApiKeyList :many
+SELECT
+  *
+FROM api_key
+WHERE user_id = ?
+  AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: UpdateApiKeyLastUsedAt :exec
+UPDATE api_key
+SET last_used_at = NOW()
+WHERE id = ?;
+
+-- name: RevokeApiKey :execrows
+UPDATE api_key
+SET revoked_at = NOW()
+WHERE id = ?
+  AND user_id = ?
+  AND revoked_at IS NULL;
\ No newline at end of file
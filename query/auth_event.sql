@@ -0,0 +1,20 @@
+-- name: CreateAuthEvent :exec
+INSERT INTO auth_event(
+  user_id,
+  phone_number,
+  type,
+  outcome,
+  reason,
+  client_ip,
+  user_agent
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetAuthEventList :many
+SELECT
+  *
+FROM auth_event
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT 10 OFFSET ?;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- name: CreateKnownDevice :exec
+INSERT INTO known_device(
+  user_id,
+  user_agent,
+  client_ip
+) VALUES (
+  ?, ?, ?
+) ON DUPLICATE KEY UPDATE last_seen_at = NOW();
+
+-- name: GetKnownDeviceList :many
+SELECT
+  *
+FROM known_device
+WHERE user_id = ?;
\ No newline at end of file
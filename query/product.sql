@@ -1,6 +1,6 @@
 -- name: CreateProduct :exec
 INSERT INTO product(
-  user_id,
+  store_id,
   category,
   price,
   cost,
@@ -17,10 +17,60 @@ INSERT INTO product(
 SELECT
   *
 FROM product
-WHERE user_id = ?
+WHERE store_id = ?
   AND SearchChosung(name, ?)
-ORDER BY created_at DESC
-LIMIT 10 OFFSET ?;
+  AND (sqlc.narg(category) IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(size) IS NULL OR size = sqlc.narg(size))
+  AND (sqlc.narg(min_price) IS NULL OR price >= sqlc.narg(min_price))
+  AND (sqlc.narg(max_price) IS NULL OR price <= sqlc.narg(max_price))
+  AND (sqlc.narg(min_cost) IS NULL OR cost >= sqlc.narg(min_cost))
+  AND (sqlc.narg(max_cost) IS NULL OR cost <= sqlc.narg(max_cost))
+  AND (sqlc.narg(expiration_date_from) IS NULL OR expiration_date >= sqlc.narg(expiration_date_from))
+  AND (sqlc.narg(expiration_date_to) IS NULL OR expiration_date <= sqlc.narg(expiration_date_to))
+  AND (sqlc.narg(created_at_from) IS NULL OR created_at >= sqlc.narg(created_at_from))
+  AND (sqlc.narg(created_at_to) IS NULL OR created_at < sqlc.narg(created_at_to))
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: GetProductCount :one
+SELECT
+  COUNT(*)
+FROM product
+WHERE store_id = ?
+  AND SearchChosung(name, ?)
+  AND (sqlc.narg(category) IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(size) IS NULL OR size = sqlc.narg(size))
+  AND (sqlc.narg(min_price) IS NULL OR price >= sqlc.narg(min_price))
+  AND (sqlc.narg(max_price) IS NULL OR price <= sqlc.narg(max_price))
+  AND (sqlc.narg(min_cost) IS NULL OR cost >= sqlc.narg(min_cost))
+  AND (sqlc.narg(max_cost) IS NULL OR cost <= sqlc.narg(max_cost))
+  AND (sqlc.narg(expiration_date_from) IS NULL OR expiration_date >= sqlc.narg(expiration_date_from))
+  AND (sqlc.narg(expiration_date_to) IS NULL OR expiration_date <= sqlc.narg(expiration_date_to))
+  AND (sqlc.narg(created_at_from) IS NULL OR created_at >= sqlc.narg(created_at_from))
+  AND (sqlc.narg(created_at_to) IS NULL OR created_at < sqlc.narg(created_at_to));
+
+-- name: GetProductListByCursor :many
+SELECT
+  *
+FROM product
+WHERE store_id = ?
+  AND SearchChosung(name, ?)
+  AND (sqlc.narg(category) IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(size) IS NULL OR size = sqlc.narg(size))
+  AND (sqlc.narg(min_price) IS NULL OR price >= sqlc.narg(min_price))
+  AND (sqlc.narg(max_price) IS NULL OR price <= sqlc.narg(max_price))
+  AND (sqlc.narg(min_cost) IS NULL OR cost >= sqlc.narg(min_cost))
+  AND (sqlc.narg(max_cost) IS NULL OR cost <= sqlc.narg(max_cost))
+  AND (sqlc.narg(expiration_date_from) IS NULL OR expiration_date >= sqlc.narg(expiration_date_from))
+  AND (sqlc.narg(expiration_date_to) IS NULL OR expiration_date <= sqlc.narg(expiration_date_to))
+  AND (sqlc.narg(created_at_from) IS NULL OR created_at >= sqlc.narg(created_at_from))
+  AND (sqlc.narg(created_at_to) IS NULL OR created_at < sqlc.narg(created_at_to))
+  AND (
+    created_at < sqlc.arg(cursor_created_at)
+    OR (created_at = sqlc.arg(cursor_created_at) AND id < sqlc.arg(cursor_id))
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
 
 -- name: GetProduct :one
 SELECT
@@ -0,0 +1,24 @@
+-- name: CreateRecoveryCode :exec
+INSERT INTO recovery_code(
+  user_id,
+  hashed_code
+) VALUES (
+  ?, ?
+);
+
+-- name: GetUnusedRecoveryCodeList :many
+SELECT
+  *
+FROM recovery_code
+WHERE user_id = ?
+  AND is_used = false;
+
+-- name: UseRecoveryCode :execrows
+UPDATE recovery_code
+SET is_used = true
+WHERE id = ?
+  AND is_used = false;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM recovery_code
+WHERE user_id = ?;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- name: CreateRevokedToken :exec
+INSERT INTO revoked_token(
+  id,
+  expired_at
+) VALUES (
+  ?, ?
+) ON DUPLICATE KEY UPDATE expired_at = GREATEST(expired_at, VALUES(expired_at));
+
+-- name: GetRevokedToken :one
+SELECT
+  *
+FROM revoked_token
+WHERE id = ?
+  AND expired_at > NOW();
+
+-- name: PurgeRevokedTokens :execrows
+DELETE FROM revoked_token
+WHERE expired_at < NOW();
\ No newline at end of file
@@ -2,17 +2,77 @@
 INSERT INTO session(
   id,
   user_id,
+  family_id,
   refresh_token,
   user_agent,
   client_ip,
   is_blocked,
   expired_at
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?
 );
 
 -- name: GetSession :one
 SELECT
   *
 FROM session
-WHERE id = ?;
\ No newline at end of file
+WHERE id = ?;
+
+-- name: GetActiveSessionList :many
+SELECT
+  *
+FROM session
+WHERE user_id = ?
+  AND is_blocked = false
+  AND is_rotated = false
+  AND expired_at > NOW()
+ORDER BY created_at DESC;
+
+-- name: GetRecentSessionList :many
+SELECT
+  *
+FROM session
+WHERE user_id = ?
+  AND created_at > ?
+ORDER BY created_at DESC;
+
+-- name: BlockSession :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE id = ?
+  AND is_blocked = false;
+
+-- name: BlockSessionFamily :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE family_id = ?
+  AND is_blocked = false;
+
+-- name: RotateSession :execrows
+UPDATE session
+SET is_rotated = true
+WHERE id = ?
+  AND is_rotated = false
+  AND is_blocked = false;
+
+-- name: BlockUserSessions :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE user_id = ?
+  AND is_blocked = false;
+
+-- name: BlockOtherSessions :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE user_id = ?
+  AND family_id != ?
+  AND is_blocked = false;
+
+-- name: PurgeSessions :execrows
+DELETE FROM session
+WHERE expired_at < NOW()
+  OR blocked_at < ?;
\ No newline at end of file
@@ -0,0 +1,30 @@
+-- name: CreateStore :execlastid
+INSERT INTO store(
+  name
+) VALUES (
+  ?
+);
+
+-- name: DeleteWithdrawnUserStores :execrows
+DELETE FROM store
+WHERE id IN (
+  SELECT store_id FROM (
+    SELECT store_member.store_id
+    FROM store_member
+    JOIN user ON user.id = store_member.user_id
+    WHERE store_member.role = 'owner'
+    GROUP BY store_member.store_id
+    HAVING SUM(user.deleted_at IS NULL OR user.deleted_at >= ?) = 0
+  ) AS orphan_store
+);
+
+-- name: GetStoreList :many
+SELECT
+  store.id,
+  store.name,
+  store_member.role,
+  store.created_at
+FROM store
+JOIN store_member ON store_member.store_id = store.id
+WHERE store_member.user_id = ?
+ORDER BY store.id;
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- name: CreateStoreInvitation :exec
+INSERT INTO store_invitation(
+  store_id,
+  phone_number,
+  role,
+  invited_by,
+  expired_at
+) VALUES (
+  ?, ?, ?, ?, ?
+);
+
+-- name: GetStoreInvitation :one
+SELECT
+  *
+FROM store_invitation
+WHERE id = ?;
+
+-- name: GetPendingStoreInvitationList :many
+SELECT
+  *
+FROM store_invitation
+WHERE phone_number = ?
+  AND accepted_at IS NULL
+  AND expired_at > NOW()
+ORDER BY created_at DESC;
+
+-- name: AcceptStoreInvitation :execrows
+UPDATE store_invitation
+SET accepted_at = NOW()
+WHERE id = ?
+  AND accepted_at IS NULL;
\ No newline at end of file
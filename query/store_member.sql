@@ -0,0 +1,28 @@
+-- name: CreateStoreMember :exec
+INSERT INTO store_member(
+  store_id,
+  user_id,
+  role
+) VALUES (
+  ?, ?, ?
+);
+
+-- name: GetStoreMember :one
+SELECT
+  *
+FROM store_member
+WHERE store_id = ?
+  AND user_id = ?;
+
+-- name: GetDefaultStoreMember :one
+SELECT
+  *
+FROM store_member
+WHERE user_id = ?
+ORDER BY created_at, store_id
+LIMIT 1;
+
+-- name: DeleteStoreMember :execrows
+DELETE FROM store_member
+WHERE store_id = ?
+  AND user_id = ?;
\ No newline at end of file
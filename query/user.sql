@@ -1,4 +1,4 @@
--- name: CreateUser :exec
+-- name: CreateUser :execlastid
 INSERT INTO user(
   phone_number,
   hashed_password
@@ -10,4 +10,55 @@ INSERT INTO user(
 SELECT
   *
 FROM user
-WHERE phone_number = ?;
\ No newline at end of file
+WHERE phone_number = ?;
+
+-- name: GetUserByID :one
+SELECT
+  *
+FROM user
+WHERE id = ?;
+
+-- name: UpdateUserPassword :exec
+UPDATE user
+SET hashed_password = ?
+WHERE id = ?;
+
+-- name: UpdateUserProfile :exec
+UPDATE user
+SET phone_number = ?, display_name = ?, shop_name = ?, timezone = ?
+WHERE id = ?;
+
+-- name: UpdateUserRole :exec
+UPDATE user
+SET role = ?
+WHERE id = ?;
+
+-- name: WithdrawUser :exec
+UPDATE user
+SET deleted_at = ?
+WHERE id = ?;
+
+-- name: RestoreUser :exec
+UPDATE user
+SET deleted_at = NULL
+WHERE id = ?;
+
+-- name: PurgeWithdrawnUsers :execrows
+DELETE FROM user
+WHERE deleted_at < ?;
+
+-- name: UpdateUserTotpSecret :exec
+UPDATE user
+SET totp_secret = ?, is_totp_enabled = false
+WHERE id = ?;
+
+-- name: EnableUserTotp :exec
+UPDATE user
+SET is_totp_enabled = true
+WHERE id = ?;
+
+-- name: UpdateUserTotpLastStep :execrows
+UPDATE user
+SET totp_last_step = sqlc.arg(totp_last_step)
+WHERE id = sqlc.arg(id)
+  AND totp_last_step < sqlc.arg(totp_last_step);
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- name: CreateVerification :exec
+INSERT INTO verification(
+  phone_number,
+  purpose,
+  hashed_code,
+  expired_at
+) VALUES (
+  ?, ?, ?, ?
+);
+
+-- name: GetLatestVerification :one
+SELECT
+  *
+FROM verification
+WHERE phone_number = ?
+  AND purpose = ?
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: IncreaseVerificationAttempt :exec
+UPDATE verification
+SET attempt_count = attempt_count + 1
+WHERE id = ?;
+
+-- name: UseVerification :execrows
+UPDATE verification
+SET is_used = true
+WHERE id = ?
+  AND is_used = false;
\ No newline at end of file
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomApiKey(t, user.ID)
+}
+
+func TestGetApiKeyList(t *testing.T) {
+	user := getRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomApiKey(t, user.ID)
+	}
+
+	// 폐기된 키는 제외
+	apiKey := createRandomApiKey(t, user.ID)
+	rows, err := testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{ID: apiKey.ID, UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	apiKeyList, err := testQueries.GetApiKeyList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, apiKeyList, 3)
+	for _, apiKey := range apiKeyList {
+		require.Equal(t, apiKey.UserID, user.ID)
+		require.False(t, apiKey.RevokedAt.Valid)
+	}
+}
+
+func TestUpdateApiKeyLastUsedAt(t *testing.T) {
+	user := getRandomUser(t)
+	apiKey1 := createRandomApiKey(t, user.ID)
+
+	err := testQueries.UpdateApiKeyLastUsedAt(context.Background(), apiKey1.ID)
+	require.NoError(t, err)
+
+	apiKey2, err := testQueries.GetApiKeyByPrefix(context.Background(), apiKey1.Prefix)
+	require.NoError(t, err)
+	require.True(t, apiKey2.LastUsedAt.Valid)
+	require.WithinDuration(t, apiKey2.LastUsedAt.Time, time.Now(), time.Second)
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	user := getRandomUser(t)
+	apiKey1 := createRandomApiKey(t, user.ID)
+
+	// 다른 회원의 키는 폐기할 수 없음
+	rows, err := testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{ID: apiKey1.ID, UserID: getRandomUser(t).ID})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	rows, err = testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{ID: apiKey1.ID, UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	// 이미 폐기된 키는 다시 폐기할 수 없음
+	rows, err = testQueries.RevokeApiKey(context.Background(), RevokeApiKeyParams{ID: apiKey1.ID, UserID: user.ID})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	apiKey2, err := testQueries.GetApiKeyByPrefix(context.Background(), apiKey1.Prefix)
+	require.NoError(t, err)
+	require.True(t, apiKey2.RevokedAt.Valid)
+}
+
+func createRandomApiKey(t *testing.T, userID int64) ApiKey {
+	arg := CreateApiKeyParams{
+		UserID:    userID,
+		Name:      util.CreateRandomString(10),
+		Prefix:    util.CreateRandomString(8),
+		HashedKey: util.CreateRandomString(64),
+		Scopes:    "products:read,products:write",
+		ExpiredAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+
+	apiKeyID, err := testQueries.CreateApiKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, apiKeyID)
+
+	apiKey, err := testQueries.GetApiKeyByPrefix(context.Background(), arg.Prefix)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, apiKeyID)
+	require.Equal(t, apiKey.UserID, arg.UserID)
+	require.Equal(t, apiKey.Name, arg.Name)
+	require.Equal(t, apiKey.Prefix, arg.Prefix)
+	require.Equal(t, apiKey.HashedKey, arg.HashedKey)
+	require.Equal(t, apiKey.Scopes, arg.Scopes)
+	require.WithinDuration(t, apiKey.ExpiredAt.Time, arg.ExpiredAt.Time, time.Second)
+	require.False(t, apiKey.LastUsedAt.Valid)
+	require.False(t, apiKey.RevokedAt.Valid)
+	require.NotZero(t, apiKey.CreatedAt)
+
+	return apiKey
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAuthEvent(t *testing.T, userID int64, outcome AuthEventOutcome) {
+	arg := CreateAuthEventParams{
+		UserID:      sql.NullInt64{Int64: userID, Valid: true},
+		PhoneNumber: sql.NullString{String: util.CreateRandomPhoneNumber(), Valid: true},
+		Type:        AuthEventTypeLogin,
+		Outcome:     outcome,
+		ClientIp:    "127.0.0.1",
+		UserAgent:   util.CreateRandomString(10),
+	}
+	if outcome == AuthEventOutcomeFailure {
+		arg.Reason = sql.NullString{String: util.CreateRandomString(10), Valid: true}
+	}
+
+	err := testQueries.CreateAuthEvent(context.Background(), arg)
+	require.NoError(t, err)
+}
+
+func TestCreateAuthEvent(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomAuthEvent(t, user.ID, AuthEventOutcomeSuccess)
+	createRandomAuthEvent(t, user.ID, AuthEventOutcomeFailure)
+
+	// 회원을 특정할 수 없는 이벤트도 기록
+	err := testQueries.CreateAuthEvent(context.Background(), CreateAuthEventParams{
+		Type:      AuthEventTypeRenewAccessToken,
+		Outcome:   AuthEventOutcomeFailure,
+		Reason:    sql.NullString{String: util.CreateRandomString(10), Valid: true},
+		ClientIp:  "127.0.0.1",
+		UserAgent: util.CreateRandomString(10),
+	})
+	require.NoError(t, err)
+}
+
+func TestGetAuthEventList(t *testing.T) {
+	user := getRandomUser(t)
+	for i := 0; i < 12; i++ {
+		createRandomAuthEvent(t, user.ID, AuthEventOutcomeSuccess)
+	}
+
+	arg := GetAuthEventListParams{
+		UserID: sql.NullInt64{Int64: user.ID, Valid: true},
+		Offset: 0,
+	}
+
+	authEventList, err := testQueries.GetAuthEventList(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, authEventList, 10)
+	for _, authEvent := range authEventList {
+		require.Equal(t, authEvent.UserID.Int64, user.ID)
+	}
+
+	arg.Offset = 10
+	authEventList, err = testQueries.GetAuthEventList(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, authEventList, 2)
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateKnownDevice(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomKnownDevice(t, user)
+}
+
+func TestCreateKnownDeviceDuplicate(t *testing.T) {
+	user := getRandomUser(t)
+	knownDevice1 := createRandomKnownDevice(t, user)
+
+	// 같은 기기, ip 조합은 마지막 로그인 시각만 갱신
+	knownDevice2 := createRandomKnownDevice(t, user)
+	require.Equal(t, knownDevice1.CreatedAt, knownDevice2.CreatedAt)
+	require.False(t, knownDevice2.LastSeenAt.Before(knownDevice1.LastSeenAt))
+
+	knownDeviceList, err := testQueries.GetKnownDeviceList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, knownDeviceList, 1)
+}
+
+func TestGetKnownDeviceList(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomKnownDevice(t, user)
+
+	// 세션이 정리되어도 기기 기록은 유지
+	createRandomSession(t, user)
+	err := testQueries.BlockUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	_, err = testQueries.PurgeSessions(context.Background(), sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true})
+	require.NoError(t, err)
+
+	knownDeviceList, err := testQueries.GetKnownDeviceList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, knownDeviceList, 1)
+	require.Equal(t, user.ID, knownDeviceList[0].UserID)
+	require.Equal(t, userAgent, knownDeviceList[0].UserAgent)
+	require.Equal(t, clientIp, knownDeviceList[0].ClientIp)
+}
+
+func createRandomKnownDevice(t *testing.T, user User) KnownDevice {
+	arg := CreateKnownDeviceParams{
+		UserID:    user.ID,
+		UserAgent: userAgent,
+		ClientIp:  clientIp,
+	}
+
+	err := testQueries.CreateKnownDevice(context.Background(), arg)
+	require.NoError(t, err)
+
+	knownDeviceList, err := testQueries.GetKnownDeviceList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, knownDeviceList, 1)
+
+	knownDevice := knownDeviceList[0]
+	require.Equal(t, arg.UserID, knownDevice.UserID)
+	require.Equal(t, arg.UserAgent, knownDevice.UserAgent)
+	require.Equal(t, arg.ClientIp, knownDevice.ClientIp)
+	require.NotZero(t, knownDevice.LastSeenAt)
+	require.NotZero(t, knownDevice.CreatedAt)
+
+	return knownDevice
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/hasher"
+	"github.com/gitaepark/pha/util/token"
 
 	_ "github.com/go-sql-driver/mysql"
 )
@@ -19,7 +21,12 @@ var (
 	testConfig = util.Config{
 		JWTSecret:            util.CreateRandomString(32),
 		RefreshTokenDuration: time.Minute,
+		Argon2Memory:         1024,
+		Argon2Iterations:     1,
+		Argon2Parallelism:    1,
 	}
+	testTokenMaker, _     = token.NewTokenMaker(testConfig)
+	testPasswordHasher, _ = hasher.NewPasswordHasher(testConfig)
 )
 
 func TestMain(m *testing.M) {
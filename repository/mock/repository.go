@@ -6,6 +6,7 @@ package mockrepository
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 
 	repository "github.com/gitaepark/pha/repository"
@@ -35,6 +36,135 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcceptStoreInvitation mocks base method.
+func (m *MockRepository) AcceptStoreInvitation(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptStoreInvitation", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptStoreInvitation indicates an expected call of AcceptStoreInvitation.
+func (mr *MockRepositoryMockRecorder) AcceptStoreInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptStoreInvitation", reflect.TypeOf((*MockRepository)(nil).AcceptStoreInvitation), arg0, arg1)
+}
+
+// AcceptStoreInvitationTx mocks base method.
+func (m *MockRepository) AcceptStoreInvitationTx(arg0 context.Context, arg1 int64, arg2 repository.CreateStoreMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptStoreInvitationTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptStoreInvitationTx indicates an expected call of AcceptStoreInvitationTx.
+func (mr *MockRepositoryMockRecorder) AcceptStoreInvitationTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptStoreInvitationTx", reflect.TypeOf((*MockRepository)(nil).AcceptStoreInvitationTx), arg0, arg1, arg2)
+}
+
+// BlockOtherSessions mocks base method.
+func (m *MockRepository) BlockOtherSessions(arg0 context.Context, arg1 repository.BlockOtherSessionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockOtherSessions indicates an expected call of BlockOtherSessions.
+func (mr *MockRepositoryMockRecorder) BlockOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOtherSessions", reflect.TypeOf((*MockRepository)(nil).BlockOtherSessions), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockRepository) BlockSession(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockRepositoryMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockRepository)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockSessionFamily mocks base method.
+func (m *MockRepository) BlockSessionFamily(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessionFamily", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSessionFamily indicates an expected call of BlockSessionFamily.
+func (mr *MockRepositoryMockRecorder) BlockSessionFamily(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessionFamily", reflect.TypeOf((*MockRepository)(nil).BlockSessionFamily), arg0, arg1)
+}
+
+// BlockUserSessions mocks base method.
+func (m *MockRepository) BlockUserSessions(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUserSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUserSessions indicates an expected call of BlockUserSessions.
+func (mr *MockRepositoryMockRecorder) BlockUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockRepository)(nil).BlockUserSessions), arg0, arg1)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockRepository) CreateApiKey(arg0 context.Context, arg1 repository.CreateApiKeyParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockRepositoryMockRecorder) CreateApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockRepository)(nil).CreateApiKey), arg0, arg1)
+}
+
+// CreateAuthEvent mocks base method.
+func (m *MockRepository) CreateAuthEvent(arg0 context.Context, arg1 repository.CreateAuthEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthEvent indicates an expected call of CreateAuthEvent.
+func (mr *MockRepositoryMockRecorder) CreateAuthEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthEvent", reflect.TypeOf((*MockRepository)(nil).CreateAuthEvent), arg0, arg1)
+}
+
+// CreateKnownDevice mocks base method.
+func (m *MockRepository) CreateKnownDevice(arg0 context.Context, arg1 repository.CreateKnownDeviceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKnownDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKnownDevice indicates an expected call of CreateKnownDevice.
+func (mr *MockRepositoryMockRecorder) CreateKnownDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKnownDevice", reflect.TypeOf((*MockRepository)(nil).CreateKnownDevice), arg0, arg1)
+}
+
 // CreateProduct mocks base method.
 func (m *MockRepository) CreateProduct(arg0 context.Context, arg1 repository.CreateProductParams) error {
 	m.ctrl.T.Helper()
@@ -49,6 +179,34 @@ func (mr *MockRepositoryMockRecorder) CreateProduct(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockRepository)(nil).CreateProduct), arg0, arg1)
 }
 
+// CreateRecoveryCode mocks base method.
+func (m *MockRepository) CreateRecoveryCode(arg0 context.Context, arg1 repository.CreateRecoveryCodeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecoveryCode indicates an expected call of CreateRecoveryCode.
+func (mr *MockRepositoryMockRecorder) CreateRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockRepository)(nil).CreateRecoveryCode), arg0, arg1)
+}
+
+// CreateRevokedToken mocks base method.
+func (m *MockRepository) CreateRevokedToken(arg0 context.Context, arg1 repository.CreateRevokedTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevokedToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevokedToken indicates an expected call of CreateRevokedToken.
+func (mr *MockRepositoryMockRecorder) CreateRevokedToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevokedToken", reflect.TypeOf((*MockRepository)(nil).CreateRevokedToken), arg0, arg1)
+}
+
 // CreateSession mocks base method.
 func (m *MockRepository) CreateSession(arg0 context.Context, arg1 repository.CreateSessionParams) error {
 	m.ctrl.T.Helper()
@@ -63,20 +221,78 @@ func (mr *MockRepositoryMockRecorder) CreateSession(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockRepository) CreateUser(arg0 context.Context, arg1 repository.CreateUserParams) error {
+// CreateStore mocks base method.
+func (m *MockRepository) CreateStore(arg0 context.Context, arg1 string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateStore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStore indicates an expected call of CreateStore.
+func (mr *MockRepositoryMockRecorder) CreateStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStore", reflect.TypeOf((*MockRepository)(nil).CreateStore), arg0, arg1)
+}
+
+// CreateStoreInvitation mocks base method.
+func (m *MockRepository) CreateStoreInvitation(arg0 context.Context, arg1 repository.CreateStoreInvitationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStoreInvitation", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CreateStoreInvitation indicates an expected call of CreateStoreInvitation.
+func (mr *MockRepositoryMockRecorder) CreateStoreInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoreInvitation", reflect.TypeOf((*MockRepository)(nil).CreateStoreInvitation), arg0, arg1)
+}
+
+// CreateStoreMember mocks base method.
+func (m *MockRepository) CreateStoreMember(arg0 context.Context, arg1 repository.CreateStoreMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStoreMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStoreMember indicates an expected call of CreateStoreMember.
+func (mr *MockRepositoryMockRecorder) CreateStoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoreMember", reflect.TypeOf((*MockRepository)(nil).CreateStoreMember), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockRepository) CreateUser(arg0 context.Context, arg1 repository.CreateUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // CreateUser indicates an expected call of CreateUser.
 func (mr *MockRepositoryMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), arg0, arg1)
 }
 
+// CreateVerification mocks base method.
+func (m *MockRepository) CreateVerification(arg0 context.Context, arg1 repository.CreateVerificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVerification indicates an expected call of CreateVerification.
+func (mr *MockRepositoryMockRecorder) CreateVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerification", reflect.TypeOf((*MockRepository)(nil).CreateVerification), arg0, arg1)
+}
+
 // DeleteProduct mocks base method.
 func (m *MockRepository) DeleteProduct(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -91,6 +307,184 @@ func (mr *MockRepositoryMockRecorder) DeleteProduct(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockRepository)(nil).DeleteProduct), arg0, arg1)
 }
 
+// DeleteRecoveryCodes mocks base method.
+func (m *MockRepository) DeleteRecoveryCodes(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecoveryCodes indicates an expected call of DeleteRecoveryCodes.
+func (mr *MockRepositoryMockRecorder) DeleteRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCodes", reflect.TypeOf((*MockRepository)(nil).DeleteRecoveryCodes), arg0, arg1)
+}
+
+// DeleteStoreMember mocks base method.
+func (m *MockRepository) DeleteStoreMember(arg0 context.Context, arg1 repository.DeleteStoreMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoreMember", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoreMember indicates an expected call of DeleteStoreMember.
+func (mr *MockRepositoryMockRecorder) DeleteStoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoreMember", reflect.TypeOf((*MockRepository)(nil).DeleteStoreMember), arg0, arg1)
+}
+
+// DeleteWithdrawnUserStores mocks base method.
+func (m *MockRepository) DeleteWithdrawnUserStores(arg0 context.Context, arg1 sql.NullTime) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithdrawnUserStores", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWithdrawnUserStores indicates an expected call of DeleteWithdrawnUserStores.
+func (mr *MockRepositoryMockRecorder) DeleteWithdrawnUserStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithdrawnUserStores", reflect.TypeOf((*MockRepository)(nil).DeleteWithdrawnUserStores), arg0, arg1)
+}
+
+// EnableUserTotp mocks base method.
+func (m *MockRepository) EnableUserTotp(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserTotp", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableUserTotp indicates an expected call of EnableUserTotp.
+func (mr *MockRepositoryMockRecorder) EnableUserTotp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserTotp", reflect.TypeOf((*MockRepository)(nil).EnableUserTotp), arg0, arg1)
+}
+
+// GetActiveSessionList mocks base method.
+func (m *MockRepository) GetActiveSessionList(arg0 context.Context, arg1 int64) ([]repository.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSessionList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSessionList indicates an expected call of GetActiveSessionList.
+func (mr *MockRepositoryMockRecorder) GetActiveSessionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSessionList", reflect.TypeOf((*MockRepository)(nil).GetActiveSessionList), arg0, arg1)
+}
+
+// GetApiKeyByPrefix mocks base method.
+func (m *MockRepository) GetApiKeyByPrefix(arg0 context.Context, arg1 string) (repository.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiKeyByPrefix", arg0, arg1)
+	ret0, _ := ret[0].(repository.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiKeyByPrefix indicates an expected call of GetApiKeyByPrefix.
+func (mr *MockRepositoryMockRecorder) GetApiKeyByPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyByPrefix", reflect.TypeOf((*MockRepository)(nil).GetApiKeyByPrefix), arg0, arg1)
+}
+
+// GetApiKeyList mocks base method.
+func (m *MockRepository) GetApiKeyList(arg0 context.Context, arg1 int64) ([]repository.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiKeyList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiKeyList indicates an expected call of GetApiKeyList.
+func (mr *MockRepositoryMockRecorder) GetApiKeyList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyList", reflect.TypeOf((*MockRepository)(nil).GetApiKeyList), arg0, arg1)
+}
+
+// GetAuthEventList mocks base method.
+func (m *MockRepository) GetAuthEventList(arg0 context.Context, arg1 repository.GetAuthEventListParams) ([]repository.AuthEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthEventList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.AuthEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthEventList indicates an expected call of GetAuthEventList.
+func (mr *MockRepositoryMockRecorder) GetAuthEventList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthEventList", reflect.TypeOf((*MockRepository)(nil).GetAuthEventList), arg0, arg1)
+}
+
+// GetDefaultStoreMember mocks base method.
+func (m *MockRepository) GetDefaultStoreMember(arg0 context.Context, arg1 int64) (repository.StoreMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultStoreMember", arg0, arg1)
+	ret0, _ := ret[0].(repository.StoreMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultStoreMember indicates an expected call of GetDefaultStoreMember.
+func (mr *MockRepositoryMockRecorder) GetDefaultStoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultStoreMember", reflect.TypeOf((*MockRepository)(nil).GetDefaultStoreMember), arg0, arg1)
+}
+
+// GetKnownDeviceList mocks base method.
+func (m *MockRepository) GetKnownDeviceList(arg0 context.Context, arg1 int64) ([]repository.KnownDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKnownDeviceList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.KnownDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKnownDeviceList indicates an expected call of GetKnownDeviceList.
+func (mr *MockRepositoryMockRecorder) GetKnownDeviceList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKnownDeviceList", reflect.TypeOf((*MockRepository)(nil).GetKnownDeviceList), arg0, arg1)
+}
+
+// GetLatestVerification mocks base method.
+func (m *MockRepository) GetLatestVerification(arg0 context.Context, arg1 repository.GetLatestVerificationParams) (repository.Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestVerification", arg0, arg1)
+	ret0, _ := ret[0].(repository.Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestVerification indicates an expected call of GetLatestVerification.
+func (mr *MockRepositoryMockRecorder) GetLatestVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestVerification", reflect.TypeOf((*MockRepository)(nil).GetLatestVerification), arg0, arg1)
+}
+
+// GetPendingStoreInvitationList mocks base method.
+func (m *MockRepository) GetPendingStoreInvitationList(arg0 context.Context, arg1 string) ([]repository.StoreInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingStoreInvitationList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.StoreInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingStoreInvitationList indicates an expected call of GetPendingStoreInvitationList.
+func (mr *MockRepositoryMockRecorder) GetPendingStoreInvitationList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingStoreInvitationList", reflect.TypeOf((*MockRepository)(nil).GetPendingStoreInvitationList), arg0, arg1)
+}
+
 // GetProduct mocks base method.
 func (m *MockRepository) GetProduct(arg0 context.Context, arg1 int64) (repository.Product, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +500,21 @@ func (mr *MockRepositoryMockRecorder) GetProduct(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockRepository)(nil).GetProduct), arg0, arg1)
 }
 
+// GetProductCount mocks base method.
+func (m *MockRepository) GetProductCount(arg0 context.Context, arg1 repository.GetProductCountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCount indicates an expected call of GetProductCount.
+func (mr *MockRepositoryMockRecorder) GetProductCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCount", reflect.TypeOf((*MockRepository)(nil).GetProductCount), arg0, arg1)
+}
+
 // GetProductList mocks base method.
 func (m *MockRepository) GetProductList(arg0 context.Context, arg1 repository.GetProductListParams) ([]repository.Product, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +530,51 @@ func (mr *MockRepositoryMockRecorder) GetProductList(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductList", reflect.TypeOf((*MockRepository)(nil).GetProductList), arg0, arg1)
 }
 
+// GetProductListByCursor mocks base method.
+func (m *MockRepository) GetProductListByCursor(arg0 context.Context, arg1 repository.GetProductListByCursorParams) ([]repository.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductListByCursor", arg0, arg1)
+	ret0, _ := ret[0].([]repository.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductListByCursor indicates an expected call of GetProductListByCursor.
+func (mr *MockRepositoryMockRecorder) GetProductListByCursor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductListByCursor", reflect.TypeOf((*MockRepository)(nil).GetProductListByCursor), arg0, arg1)
+}
+
+// GetRecentSessionList mocks base method.
+func (m *MockRepository) GetRecentSessionList(arg0 context.Context, arg1 repository.GetRecentSessionListParams) ([]repository.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentSessionList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSessionList indicates an expected call of GetRecentSessionList.
+func (mr *MockRepositoryMockRecorder) GetRecentSessionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSessionList", reflect.TypeOf((*MockRepository)(nil).GetRecentSessionList), arg0, arg1)
+}
+
+// GetRevokedToken mocks base method.
+func (m *MockRepository) GetRevokedToken(arg0 context.Context, arg1 string) (repository.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedToken", arg0, arg1)
+	ret0, _ := ret[0].(repository.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevokedToken indicates an expected call of GetRevokedToken.
+func (mr *MockRepositoryMockRecorder) GetRevokedToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedToken", reflect.TypeOf((*MockRepository)(nil).GetRevokedToken), arg0, arg1)
+}
+
 // GetSession mocks base method.
 func (m *MockRepository) GetSession(arg0 context.Context, arg1 string) (repository.Session, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +590,66 @@ func (mr *MockRepositoryMockRecorder) GetSession(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepository)(nil).GetSession), arg0, arg1)
 }
 
+// GetStoreInvitation mocks base method.
+func (m *MockRepository) GetStoreInvitation(arg0 context.Context, arg1 int64) (repository.StoreInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreInvitation", arg0, arg1)
+	ret0, _ := ret[0].(repository.StoreInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreInvitation indicates an expected call of GetStoreInvitation.
+func (mr *MockRepositoryMockRecorder) GetStoreInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreInvitation", reflect.TypeOf((*MockRepository)(nil).GetStoreInvitation), arg0, arg1)
+}
+
+// GetStoreList mocks base method.
+func (m *MockRepository) GetStoreList(arg0 context.Context, arg1 int64) ([]repository.GetStoreListRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.GetStoreListRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreList indicates an expected call of GetStoreList.
+func (mr *MockRepositoryMockRecorder) GetStoreList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreList", reflect.TypeOf((*MockRepository)(nil).GetStoreList), arg0, arg1)
+}
+
+// GetStoreMember mocks base method.
+func (m *MockRepository) GetStoreMember(arg0 context.Context, arg1 repository.GetStoreMemberParams) (repository.StoreMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreMember", arg0, arg1)
+	ret0, _ := ret[0].(repository.StoreMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoreMember indicates an expected call of GetStoreMember.
+func (mr *MockRepositoryMockRecorder) GetStoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreMember", reflect.TypeOf((*MockRepository)(nil).GetStoreMember), arg0, arg1)
+}
+
+// GetUnusedRecoveryCodeList mocks base method.
+func (m *MockRepository) GetUnusedRecoveryCodeList(arg0 context.Context, arg1 int64) ([]repository.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnusedRecoveryCodeList", arg0, arg1)
+	ret0, _ := ret[0].([]repository.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnusedRecoveryCodeList indicates an expected call of GetUnusedRecoveryCodeList.
+func (mr *MockRepositoryMockRecorder) GetUnusedRecoveryCodeList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnusedRecoveryCodeList", reflect.TypeOf((*MockRepository)(nil).GetUnusedRecoveryCodeList), arg0, arg1)
+}
+
 // GetUser mocks base method.
 func (m *MockRepository) GetUser(arg0 context.Context, arg1 string) (repository.User, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +665,183 @@ func (mr *MockRepositoryMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserByID mocks base method.
+func (m *MockRepository) GetUserByID(arg0 context.Context, arg1 int64) (repository.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", arg0, arg1)
+	ret0, _ := ret[0].(repository.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockRepositoryMockRecorder) GetUserByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockRepository)(nil).GetUserByID), arg0, arg1)
+}
+
+// IncreaseVerificationAttempt mocks base method.
+func (m *MockRepository) IncreaseVerificationAttempt(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseVerificationAttempt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseVerificationAttempt indicates an expected call of IncreaseVerificationAttempt.
+func (mr *MockRepositoryMockRecorder) IncreaseVerificationAttempt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseVerificationAttempt", reflect.TypeOf((*MockRepository)(nil).IncreaseVerificationAttempt), arg0, arg1)
+}
+
+// PurgeRevokedTokens mocks base method.
+func (m *MockRepository) PurgeRevokedTokens(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRevokedTokens", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRevokedTokens indicates an expected call of PurgeRevokedTokens.
+func (mr *MockRepositoryMockRecorder) PurgeRevokedTokens(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRevokedTokens", reflect.TypeOf((*MockRepository)(nil).PurgeRevokedTokens), arg0)
+}
+
+// PurgeSessions mocks base method.
+func (m *MockRepository) PurgeSessions(arg0 context.Context, arg1 sql.NullTime) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeSessions indicates an expected call of PurgeSessions.
+func (mr *MockRepositoryMockRecorder) PurgeSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSessions", reflect.TypeOf((*MockRepository)(nil).PurgeSessions), arg0, arg1)
+}
+
+// PurgeWithdrawnUsers mocks base method.
+func (m *MockRepository) PurgeWithdrawnUsers(arg0 context.Context, arg1 sql.NullTime) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWithdrawnUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeWithdrawnUsers indicates an expected call of PurgeWithdrawnUsers.
+func (mr *MockRepositoryMockRecorder) PurgeWithdrawnUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWithdrawnUsers", reflect.TypeOf((*MockRepository)(nil).PurgeWithdrawnUsers), arg0, arg1)
+}
+
+// PurgeWithdrawnUsersTx mocks base method.
+func (m *MockRepository) PurgeWithdrawnUsersTx(arg0 context.Context, arg1 sql.NullTime) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWithdrawnUsersTx", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeWithdrawnUsersTx indicates an expected call of PurgeWithdrawnUsersTx.
+func (mr *MockRepositoryMockRecorder) PurgeWithdrawnUsersTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWithdrawnUsersTx", reflect.TypeOf((*MockRepository)(nil).PurgeWithdrawnUsersTx), arg0, arg1)
+}
+
+// RegisterTx mocks base method.
+func (m *MockRepository) RegisterTx(arg0 context.Context, arg1 repository.CreateUserParams, arg2 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTx indicates an expected call of RegisterTx.
+func (mr *MockRepositoryMockRecorder) RegisterTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTx", reflect.TypeOf((*MockRepository)(nil).RegisterTx), arg0, arg1, arg2)
+}
+
+// RestoreUser mocks base method.
+func (m *MockRepository) RestoreUser(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockRepositoryMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockRepository)(nil).RestoreUser), arg0, arg1)
+}
+
+// RevokeApiKey mocks base method.
+func (m *MockRepository) RevokeApiKey(arg0 context.Context, arg1 repository.RevokeApiKeyParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockRepositoryMockRecorder) RevokeApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockRepository)(nil).RevokeApiKey), arg0, arg1)
+}
+
+// RotateSession mocks base method.
+func (m *MockRepository) RotateSession(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockRepositoryMockRecorder) RotateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockRepository)(nil).RotateSession), arg0, arg1)
+}
+
+// RotateSessionTx mocks base method.
+func (m *MockRepository) RotateSessionTx(arg0 context.Context, arg1 string, arg2 repository.CreateSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSessionTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSessionTx indicates an expected call of RotateSessionTx.
+func (mr *MockRepositoryMockRecorder) RotateSessionTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSessionTx", reflect.TypeOf((*MockRepository)(nil).RotateSessionTx), arg0, arg1, arg2)
+}
+
+// UpdateApiKeyLastUsedAt mocks base method.
+func (m *MockRepository) UpdateApiKeyLastUsedAt(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApiKeyLastUsedAt", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApiKeyLastUsedAt indicates an expected call of UpdateApiKeyLastUsedAt.
+func (mr *MockRepositoryMockRecorder) UpdateApiKeyLastUsedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApiKeyLastUsedAt", reflect.TypeOf((*MockRepository)(nil).UpdateApiKeyLastUsedAt), arg0, arg1)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockRepository) UpdateProduct(arg0 context.Context, arg1 repository.UpdateProductParams) error {
 	m.ctrl.T.Helper()
@@ -164,3 +855,118 @@ func (mr *MockRepositoryMockRecorder) UpdateProduct(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockRepository)(nil).UpdateProduct), arg0, arg1)
 }
+
+// UpdateUserPassword mocks base method.
+func (m *MockRepository) UpdateUserPassword(arg0 context.Context, arg1 repository.UpdateUserPasswordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPassword indicates an expected call of UpdateUserPassword.
+func (mr *MockRepositoryMockRecorder) UpdateUserPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockRepository)(nil).UpdateUserPassword), arg0, arg1)
+}
+
+// UpdateUserProfile mocks base method.
+func (m *MockRepository) UpdateUserProfile(arg0 context.Context, arg1 repository.UpdateUserProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile.
+func (mr *MockRepositoryMockRecorder) UpdateUserProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockRepository)(nil).UpdateUserProfile), arg0, arg1)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockRepository) UpdateUserRole(arg0 context.Context, arg1 repository.UpdateUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockRepositoryMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockRepository)(nil).UpdateUserRole), arg0, arg1)
+}
+
+// UpdateUserTotpLastStep mocks base method.
+func (m *MockRepository) UpdateUserTotpLastStep(arg0 context.Context, arg1 repository.UpdateUserTotpLastStepParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTotpLastStep", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTotpLastStep indicates an expected call of UpdateUserTotpLastStep.
+func (mr *MockRepositoryMockRecorder) UpdateUserTotpLastStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTotpLastStep", reflect.TypeOf((*MockRepository)(nil).UpdateUserTotpLastStep), arg0, arg1)
+}
+
+// UpdateUserTotpSecret mocks base method.
+func (m *MockRepository) UpdateUserTotpSecret(arg0 context.Context, arg1 repository.UpdateUserTotpSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTotpSecret", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTotpSecret indicates an expected call of UpdateUserTotpSecret.
+func (mr *MockRepositoryMockRecorder) UpdateUserTotpSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTotpSecret", reflect.TypeOf((*MockRepository)(nil).UpdateUserTotpSecret), arg0, arg1)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockRepository) UseRecoveryCode(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockRepositoryMockRecorder) UseRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockRepository)(nil).UseRecoveryCode), arg0, arg1)
+}
+
+// UseVerification mocks base method.
+func (m *MockRepository) UseVerification(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseVerification", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseVerification indicates an expected call of UseVerification.
+func (mr *MockRepositoryMockRecorder) UseVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseVerification", reflect.TypeOf((*MockRepository)(nil).UseVerification), arg0, arg1)
+}
+
+// WithdrawUser mocks base method.
+func (m *MockRepository) WithdrawUser(arg0 context.Context, arg1 repository.WithdrawUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawUser indicates an expected call of WithdrawUser.
+func (mr *MockRepositoryMockRecorder) WithdrawUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawUser", reflect.TypeOf((*MockRepository)(nil).WithdrawUser), arg0, arg1)
+}
@@ -5,11 +5,102 @@
 package repository
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"time"
 )
 
+type AuthEventOutcome string
+
+const (
+	AuthEventOutcomeSuccess AuthEventOutcome = "success"
+	AuthEventOutcomeFailure AuthEventOutcome = "failure"
+)
+
+func (e *AuthEventOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuthEventOutcome(s)
+	case string:
+		*e = AuthEventOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuthEventOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullAuthEventOutcome struct {
+	AuthEventOutcome AuthEventOutcome
+	Valid            bool // Valid is true if AuthEventOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuthEventOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuthEventOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuthEventOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuthEventOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuthEventOutcome), nil
+}
+
+type AuthEventType string
+
+const (
+	AuthEventTypeRegister         AuthEventType = "register"
+	AuthEventTypeLogin            AuthEventType = "login"
+	AuthEventTypeLoginTwoFactor   AuthEventType = "login_two_factor"
+	AuthEventTypeRenewAccessToken AuthEventType = "renew_access_token"
+	AuthEventTypeLogout           AuthEventType = "logout"
+	AuthEventTypeChangePassword   AuthEventType = "change_password"
+	AuthEventTypeNewDeviceLogin   AuthEventType = "new_device_login"
+	AuthEventTypeResetPassword    AuthEventType = "reset_password"
+)
+
+func (e *AuthEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuthEventType(s)
+	case string:
+		*e = AuthEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuthEventType: %T", src)
+	}
+	return nil
+}
+
+type NullAuthEventType struct {
+	AuthEventType AuthEventType
+	Valid         bool // Valid is true if AuthEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuthEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuthEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuthEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuthEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuthEventType), nil
+}
+
 type ProductSize string
 
 const (
@@ -52,9 +143,212 @@ func (ns NullProductSize) Value() (driver.Value, error) {
 	return string(ns.ProductSize), nil
 }
 
+type StoreInvitationRole string
+
+const (
+	StoreInvitationRoleOwner StoreInvitationRole = "owner"
+	StoreInvitationRoleStaff StoreInvitationRole = "staff"
+)
+
+func (e *StoreInvitationRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StoreInvitationRole(s)
+	case string:
+		*e = StoreInvitationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StoreInvitationRole: %T", src)
+	}
+	return nil
+}
+
+type NullStoreInvitationRole struct {
+	StoreInvitationRole StoreInvitationRole
+	Valid               bool // Valid is true if StoreInvitationRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStoreInvitationRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.StoreInvitationRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StoreInvitationRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStoreInvitationRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StoreInvitationRole), nil
+}
+
+type StoreMemberRole string
+
+const (
+	StoreMemberRoleOwner StoreMemberRole = "owner"
+	StoreMemberRoleStaff StoreMemberRole = "staff"
+)
+
+func (e *StoreMemberRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StoreMemberRole(s)
+	case string:
+		*e = StoreMemberRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StoreMemberRole: %T", src)
+	}
+	return nil
+}
+
+type NullStoreMemberRole struct {
+	StoreMemberRole StoreMemberRole
+	Valid           bool // Valid is true if StoreMemberRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStoreMemberRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.StoreMemberRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StoreMemberRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStoreMemberRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StoreMemberRole), nil
+}
+
+type UserRole string
+
+const (
+	UserRoleOwner UserRole = "owner"
+	UserRoleStaff UserRole = "staff"
+	UserRoleAdmin UserRole = "admin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole
+	Valid    bool // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
+type VerificationPurpose string
+
+const (
+	VerificationPurposeRegister          VerificationPurpose = "register"
+	VerificationPurposePasswordReset     VerificationPurpose = "password_reset"
+	VerificationPurposeChangePhoneNumber VerificationPurpose = "change_phone_number"
+)
+
+func (e *VerificationPurpose) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VerificationPurpose(s)
+	case string:
+		*e = VerificationPurpose(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VerificationPurpose: %T", src)
+	}
+	return nil
+}
+
+type NullVerificationPurpose struct {
+	VerificationPurpose VerificationPurpose
+	Valid               bool // Valid is true if VerificationPurpose is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVerificationPurpose) Scan(value interface{}) error {
+	if value == nil {
+		ns.VerificationPurpose, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.VerificationPurpose.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVerificationPurpose) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.VerificationPurpose), nil
+}
+
+type ApiKey struct {
+	ID         int64        `json:"id"`
+	UserID     int64        `json:"user_id"`
+	Name       string       `json:"name"`
+	Prefix     string       `json:"prefix"`
+	HashedKey  string       `json:"hashed_key"`
+	Scopes     string       `json:"scopes"`
+	ExpiredAt  sql.NullTime `json:"expired_at"`
+	LastUsedAt sql.NullTime `json:"last_used_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type AuthEvent struct {
+	ID          int64            `json:"id"`
+	UserID      sql.NullInt64    `json:"user_id"`
+	PhoneNumber sql.NullString   `json:"phone_number"`
+	Type        AuthEventType    `json:"type"`
+	Outcome     AuthEventOutcome `json:"outcome"`
+	Reason      sql.NullString   `json:"reason"`
+	ClientIp    string           `json:"client_ip"`
+	UserAgent   string           `json:"user_agent"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+type KnownDevice struct {
+	UserID     int64     `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	ClientIp   string    `json:"client_ip"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 type Product struct {
 	ID             int64       `json:"id"`
-	UserID         int64       `json:"user_id"`
+	StoreID        int64       `json:"store_id"`
 	Category       string      `json:"category"`
 	Price          int32       `json:"price"`
 	Cost           int32       `json:"cost"`
@@ -67,20 +361,80 @@ type Product struct {
 	UpdatedAt      time.Time   `json:"updated_at"`
 }
 
+type RecoveryCode struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	HashedCode string    `json:"hashed_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type RevokedToken struct {
+	ID        string    `json:"id"`
+	ExpiredAt time.Time `json:"expired_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Session struct {
-	ID           string    `json:"id"`
-	UserID       int64     `json:"user_id"`
-	RefreshToken string    `json:"refresh_token"`
-	UserAgent    string    `json:"user_agent"`
-	ClientIp     string    `json:"client_ip"`
-	IsBlocked    bool      `json:"is_blocked"`
-	ExpiredAt    time.Time `json:"expired_at"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID           string       `json:"id"`
+	UserID       int64        `json:"user_id"`
+	FamilyID     string       `json:"family_id"`
+	RefreshToken string       `json:"refresh_token"`
+	UserAgent    string       `json:"user_agent"`
+	ClientIp     string       `json:"client_ip"`
+	IsBlocked    bool         `json:"is_blocked"`
+	IsRotated    bool         `json:"is_rotated"`
+	BlockedAt    sql.NullTime `json:"blocked_at"`
+	ExpiredAt    time.Time    `json:"expired_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type Store struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type StoreInvitation struct {
+	ID          int64               `json:"id"`
+	StoreID     int64               `json:"store_id"`
+	PhoneNumber string              `json:"phone_number"`
+	Role        StoreInvitationRole `json:"role"`
+	InvitedBy   int64               `json:"invited_by"`
+	AcceptedAt  sql.NullTime        `json:"accepted_at"`
+	ExpiredAt   time.Time           `json:"expired_at"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+type StoreMember struct {
+	StoreID   int64           `json:"store_id"`
+	UserID    int64           `json:"user_id"`
+	Role      StoreMemberRole `json:"role"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type User struct {
-	ID             int64     `json:"id"`
-	PhoneNumber    string    `json:"phone_number"`
-	HashedPassword string    `json:"hashed_password"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             int64          `json:"id"`
+	PhoneNumber    string         `json:"phone_number"`
+	HashedPassword string         `json:"hashed_password"`
+	Role           UserRole       `json:"role"`
+	DisplayName    sql.NullString `json:"display_name"`
+	ShopName       sql.NullString `json:"shop_name"`
+	Timezone       string         `json:"timezone"`
+	TotpSecret     sql.NullString `json:"totp_secret"`
+	IsTotpEnabled  bool           `json:"is_totp_enabled"`
+	TotpLastStep   int64          `json:"totp_last_step"`
+	CreatedAt      time.Time      `json:"created_at"`
+	DeletedAt      sql.NullTime   `json:"deleted_at"`
+}
+
+type Verification struct {
+	ID           int64               `json:"id"`
+	PhoneNumber  string              `json:"phone_number"`
+	Purpose      VerificationPurpose `json:"purpose"`
+	HashedCode   string              `json:"hashed_code"`
+	AttemptCount int32               `json:"attempt_count"`
+	IsUsed       bool                `json:"is_used"`
+	ExpiredAt    time.Time           `json:"expired_at"`
+	CreatedAt    time.Time           `json:"created_at"`
 }
@@ -7,12 +7,13 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const createProduct = `-- name: CreateProduct :exec
 INSERT INTO product(
-  user_id,
+  store_id,
   category,
   price,
   cost,
@@ -27,7 +28,7 @@ INSERT INTO product(
 `
 
 type CreateProductParams struct {
-	UserID         int64       `json:"user_id"`
+	StoreID        int64       `json:"store_id"`
 	Category       string      `json:"category"`
 	Price          int32       `json:"price"`
 	Cost           int32       `json:"cost"`
@@ -40,7 +41,7 @@ type CreateProductParams struct {
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) error {
 	_, err := q.db.ExecContext(ctx, createProduct,
-		arg.UserID,
+		arg.StoreID,
 		arg.Category,
 		arg.Price,
 		arg.Cost,
@@ -66,7 +67,7 @@ func (q *Queries) DeleteProduct(ctx context.Context, id int64) error {
 
 const getProduct = `-- name: GetProduct :one
 SELECT
-  id, user_id, category, price, cost, name, description, barcode, expiration_date, size, created_at, updated_at
+  id, store_id, category, price, cost, name, description, barcode, expiration_date, size, created_at, updated_at
 FROM product
 WHERE id = ?
 `
@@ -76,7 +77,7 @@ func (q *Queries) GetProduct(ctx context.Context, id int64) (Product, error) {
 	var i Product
 	err := row.Scan(
 		&i.ID,
-		&i.UserID,
+		&i.StoreID,
 		&i.Category,
 		&i.Price,
 		&i.Cost,
@@ -91,24 +92,238 @@ func (q *Queries) GetProduct(ctx context.Context, id int64) (Product, error) {
 	return i, err
 }
 
+const getProductCount = `-- name: GetProductCount :one
+SELECT
+  COUNT(*)
+FROM product
+WHERE store_id = ?
+  AND SearchChosung(name, ?)
+  AND (? IS NULL OR category = ?)
+  AND (? IS NULL OR size = ?)
+  AND (? IS NULL OR price >= ?)
+  AND (? IS NULL OR price <= ?)
+  AND (? IS NULL OR cost >= ?)
+  AND (? IS NULL OR cost <= ?)
+  AND (? IS NULL OR expiration_date >= ?)
+  AND (? IS NULL OR expiration_date <= ?)
+  AND (? IS NULL OR created_at >= ?)
+  AND (? IS NULL OR created_at < ?)
+`
+
+type GetProductCountParams struct {
+	StoreID            int64           `json:"store_id"`
+	Searchchosung      interface{}     `json:"searchchosung"`
+	Category           sql.NullString  `json:"category"`
+	Size               NullProductSize `json:"size"`
+	MinPrice           sql.NullInt32   `json:"min_price"`
+	MaxPrice           sql.NullInt32   `json:"max_price"`
+	MinCost            sql.NullInt32   `json:"min_cost"`
+	MaxCost            sql.NullInt32   `json:"max_cost"`
+	ExpirationDateFrom sql.NullTime    `json:"expiration_date_from"`
+	ExpirationDateTo   sql.NullTime    `json:"expiration_date_to"`
+	CreatedAtFrom      sql.NullTime    `json:"created_at_from"`
+	CreatedAtTo        sql.NullTime    `json:"created_at_to"`
+}
+
+func (q *Queries) GetProductCount(ctx context.Context, arg GetProductCountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getProductCount,
+		arg.StoreID,
+		arg.Searchchosung,
+		arg.Category,
+		arg.Category,
+		arg.Size,
+		arg.Size,
+		arg.MinPrice,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MaxPrice,
+		arg.MinCost,
+		arg.MinCost,
+		arg.MaxCost,
+		arg.MaxCost,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateTo,
+		arg.ExpirationDateTo,
+		arg.CreatedAtFrom,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+		arg.CreatedAtTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getProductList = `-- name: GetProductList :many
 SELECT
-  id, user_id, category, price, cost, name, description, barcode, expiration_date, size, created_at, updated_at
+  id, store_id, category, price, cost, name, description, barcode, expiration_date, size, created_at, updated_at
 FROM product
-WHERE user_id = ?
+WHERE store_id = ?
   AND SearchChosung(name, ?)
-ORDER BY created_at DESC
-LIMIT 10 OFFSET ?
+  AND (? IS NULL OR category = ?)
+  AND (? IS NULL OR size = ?)
+  AND (? IS NULL OR price >= ?)
+  AND (? IS NULL OR price <= ?)
+  AND (? IS NULL OR cost >= ?)
+  AND (? IS NULL OR cost <= ?)
+  AND (? IS NULL OR expiration_date >= ?)
+  AND (? IS NULL OR expiration_date <= ?)
+  AND (? IS NULL OR created_at >= ?)
+  AND (? IS NULL OR created_at < ?)
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?
 `
 
 type GetProductListParams struct {
-	UserID        int64       `json:"user_id"`
-	Searchchosung interface{} `json:"searchchosung"`
-	Offset        int32       `json:"offset"`
+	StoreID            int64           `json:"store_id"`
+	Searchchosung      interface{}     `json:"searchchosung"`
+	Category           sql.NullString  `json:"category"`
+	Size               NullProductSize `json:"size"`
+	MinPrice           sql.NullInt32   `json:"min_price"`
+	MaxPrice           sql.NullInt32   `json:"max_price"`
+	MinCost            sql.NullInt32   `json:"min_cost"`
+	MaxCost            sql.NullInt32   `json:"max_cost"`
+	ExpirationDateFrom sql.NullTime    `json:"expiration_date_from"`
+	ExpirationDateTo   sql.NullTime    `json:"expiration_date_to"`
+	CreatedAtFrom      sql.NullTime    `json:"created_at_from"`
+	CreatedAtTo        sql.NullTime    `json:"created_at_to"`
+	Limit              int32           `json:"limit"`
+	Offset             int32           `json:"offset"`
 }
 
 func (q *Queries) GetProductList(ctx context.Context, arg GetProductListParams) ([]Product, error) {
-	rows, err := q.db.QueryContext(ctx, getProductList, arg.UserID, arg.Searchchosung, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getProductList,
+		arg.StoreID,
+		arg.Searchchosung,
+		arg.Category,
+		arg.Category,
+		arg.Size,
+		arg.Size,
+		arg.MinPrice,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MaxPrice,
+		arg.MinCost,
+		arg.MinCost,
+		arg.MaxCost,
+		arg.MaxCost,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateTo,
+		arg.ExpirationDateTo,
+		arg.CreatedAtFrom,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+		arg.CreatedAtTo,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.StoreID,
+			&i.Category,
+			&i.Price,
+			&i.Cost,
+			&i.Name,
+			&i.Description,
+			&i.Barcode,
+			&i.ExpirationDate,
+			&i.Size,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductListByCursor = `-- name: GetProductListByCursor :many
+SELECT
+  id, store_id, category, price, cost, name, description, barcode, expiration_date, size, created_at, updated_at
+FROM product
+WHERE store_id = ?
+  AND SearchChosung(name, ?)
+  AND (? IS NULL OR category = ?)
+  AND (? IS NULL OR size = ?)
+  AND (? IS NULL OR price >= ?)
+  AND (? IS NULL OR price <= ?)
+  AND (? IS NULL OR cost >= ?)
+  AND (? IS NULL OR cost <= ?)
+  AND (? IS NULL OR expiration_date >= ?)
+  AND (? IS NULL OR expiration_date <= ?)
+  AND (? IS NULL OR created_at >= ?)
+  AND (? IS NULL OR created_at < ?)
+  AND (
+    created_at < ?
+    OR (created_at = ? AND id < ?)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+type GetProductListByCursorParams struct {
+	StoreID            int64           `json:"store_id"`
+	Searchchosung      interface{}     `json:"searchchosung"`
+	Category           sql.NullString  `json:"category"`
+	Size               NullProductSize `json:"size"`
+	MinPrice           sql.NullInt32   `json:"min_price"`
+	MaxPrice           sql.NullInt32   `json:"max_price"`
+	MinCost            sql.NullInt32   `json:"min_cost"`
+	MaxCost            sql.NullInt32   `json:"max_cost"`
+	ExpirationDateFrom sql.NullTime    `json:"expiration_date_from"`
+	ExpirationDateTo   sql.NullTime    `json:"expiration_date_to"`
+	CreatedAtFrom      sql.NullTime    `json:"created_at_from"`
+	CreatedAtTo        sql.NullTime    `json:"created_at_to"`
+	CursorCreatedAt    time.Time       `json:"cursor_created_at"`
+	CursorID           int64           `json:"cursor_id"`
+	Limit              int32           `json:"limit"`
+}
+
+func (q *Queries) GetProductListByCursor(ctx context.Context, arg GetProductListByCursorParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, getProductListByCursor,
+		arg.StoreID,
+		arg.Searchchosung,
+		arg.Category,
+		arg.Category,
+		arg.Size,
+		arg.Size,
+		arg.MinPrice,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MaxPrice,
+		arg.MinCost,
+		arg.MinCost,
+		arg.MaxCost,
+		arg.MaxCost,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateFrom,
+		arg.ExpirationDateTo,
+		arg.ExpirationDateTo,
+		arg.CreatedAtFrom,
+		arg.CreatedAtFrom,
+		arg.CreatedAtTo,
+		arg.CreatedAtTo,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +333,7 @@ func (q *Queries) GetProductList(ctx context.Context, arg GetProductListParams)
 		var i Product
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
+			&i.StoreID,
 			&i.Category,
 			&i.Price,
 			&i.Cost,
@@ -13,19 +13,20 @@ import (
 )
 
 func TestCreateProduct(t *testing.T) {
-	user := getRandomUser(t)
-	createRandomProduct(t, user)
+	store := createRandomStore(t, getRandomUser(t))
+	createRandomProduct(t, store)
 }
 
 func TestGetProductList(t *testing.T) {
-	user := getRandomUser(t)
+	store := createRandomStore(t, getRandomUser(t))
 	for i := 0; i < 10; i++ {
-		createRandomProduct(t, user)
+		createRandomProduct(t, store)
 	}
 
 	arg := GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "",
+		Limit:         10,
 		Offset:        5,
 	}
 
@@ -50,13 +51,61 @@ func TestGetProductList(t *testing.T) {
 	}
 }
 
+func TestGetProductListByCursor(t *testing.T) {
+	store := createRandomStore(t, getRandomUser(t))
+	for i := 0; i < 10; i++ {
+		createRandomProduct(t, store)
+	}
+
+	firstPage, err := testQueries.GetProductList(context.Background(), GetProductListParams{
+		StoreID:       store.ID,
+		Searchchosung: "",
+		Limit:         10,
+		Offset:        0,
+	})
+	require.NoError(t, err)
+	require.Len(t, firstPage, 10)
+
+	// 5번째 상품 이후부터 조회 (같은 생성 시각은 id로 구분)
+	cursorProduct := firstPage[4]
+	arg := GetProductListByCursorParams{
+		StoreID:         store.ID,
+		Searchchosung:   "",
+		CursorCreatedAt: cursorProduct.CreatedAt,
+		CursorID:        cursorProduct.ID,
+		Limit:           10,
+	}
+
+	productList, err := testQueries.GetProductListByCursor(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, productList, 5)
+
+	for idx, product := range productList {
+		require.Equal(t, firstPage[5+idx].ID, product.ID)
+	}
+}
+
+func TestGetProductCount(t *testing.T) {
+	store := createRandomStore(t, getRandomUser(t))
+	for i := 0; i < 3; i++ {
+		createRandomProduct(t, store)
+	}
+
+	count, err := testQueries.GetProductCount(context.Background(), GetProductCountParams{
+		StoreID:       store.ID,
+		Searchchosung: "",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
 func TestGetProductListWithKeyword(t *testing.T) {
-	user := getRandomUser(t)
+	store := createRandomStore(t, getRandomUser(t))
 	for i := 0; i < 9; i++ {
-		createRandomProduct(t, user)
+		createRandomProduct(t, store)
 	}
 	testQueries.CreateProduct(context.Background(), CreateProductParams{
-		UserID:         user.ID,
+		StoreID:        store.ID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
@@ -68,8 +117,9 @@ func TestGetProductListWithKeyword(t *testing.T) {
 	})
 
 	arg := GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "슈크림",
+		Limit:         10,
 		Offset:        0,
 	}
 
@@ -92,12 +142,12 @@ func TestGetProductListWithKeyword(t *testing.T) {
 }
 
 func TestGetProductListWithChosungKeyword(t *testing.T) {
-	user := getRandomUser(t)
+	store := createRandomStore(t, getRandomUser(t))
 	for i := 0; i < 9; i++ {
-		createRandomProduct(t, user)
+		createRandomProduct(t, store)
 	}
 	testQueries.CreateProduct(context.Background(), CreateProductParams{
-		UserID:         user.ID,
+		StoreID:        store.ID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
@@ -109,8 +159,9 @@ func TestGetProductListWithChosungKeyword(t *testing.T) {
 	})
 
 	arg := GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "ㅅㅋㄹ",
+		Limit:         10,
 		Offset:        0,
 	}
 
@@ -132,12 +183,81 @@ func TestGetProductListWithChosungKeyword(t *testing.T) {
 	require.NotZero(t, productList[0].UpdatedAt)
 }
 
+func TestGetProductListWithFilter(t *testing.T) {
+	store := createRandomStore(t, getRandomUser(t))
+	for i := 0; i < 9; i++ {
+		createRandomProduct(t, store)
+	}
+	expirationDate := time.Now().AddDate(0, 0, 3)
+	err := testQueries.CreateProduct(context.Background(), CreateProductParams{
+		StoreID:        store.ID,
+		Category:       "음료",
+		Price:          1500,
+		Cost:           util.CreateRandomInt32(1000, 10000),
+		Name:           util.CreateRandomString(10),
+		Description:    util.CreateRandomString(50),
+		Barcode:        util.CreateRandomString(12),
+		ExpirationDate: expirationDate,
+		Size:           ProductSizeSmall,
+	})
+	require.NoError(t, err)
+
+	// 이번 주 유통기한이 만료되는 음료
+	category := sql.NullString{String: "음료", Valid: true}
+	size := NullProductSize{ProductSize: ProductSizeSmall, Valid: true}
+	maxPrice := sql.NullInt32{Int32: 2000, Valid: true}
+	expirationDateFrom := sql.NullTime{Time: time.Now().AddDate(0, 0, -1), Valid: true}
+	expirationDateTo := sql.NullTime{Time: time.Now().AddDate(0, 0, 7), Valid: true}
+
+	productList, err := testQueries.GetProductList(context.Background(), GetProductListParams{
+		StoreID:            store.ID,
+		Searchchosung:      "",
+		Category:           category,
+		Size:               size,
+		MaxPrice:           maxPrice,
+		ExpirationDateFrom: expirationDateFrom,
+		ExpirationDateTo:   expirationDateTo,
+		Limit:              10,
+		Offset:             0,
+	})
+	require.NoError(t, err)
+	require.Len(t, productList, 1)
+	require.Equal(t, "음료", productList[0].Category)
+	require.Equal(t, int32(1500), productList[0].Price)
+	require.Equal(t, ProductSizeSmall, productList[0].Size)
+
+	count, err := testQueries.GetProductCount(context.Background(), GetProductCountParams{
+		StoreID:            store.ID,
+		Searchchosung:      "",
+		Category:           category,
+		Size:               size,
+		MaxPrice:           maxPrice,
+		ExpirationDateFrom: expirationDateFrom,
+		ExpirationDateTo:   expirationDateTo,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// 범위에 해당하는 상품이 없는 경우
+	productList, err = testQueries.GetProductList(context.Background(), GetProductListParams{
+		StoreID:       store.ID,
+		Searchchosung: "",
+		Category:      category,
+		MinPrice:      sql.NullInt32{Int32: 1501, Valid: true},
+		Limit:         10,
+		Offset:        0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, productList)
+}
+
 func TestGetProduct(t *testing.T) {
-	user := getRandomUser(t)
-	createRandomProduct(t, user)
+	store := createRandomStore(t, getRandomUser(t))
+	createRandomProduct(t, store)
 	productList, _ := testQueries.GetProductList(context.Background(), GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "",
+		Limit:         10,
 		Offset:        0,
 	})
 
@@ -146,7 +266,7 @@ func TestGetProduct(t *testing.T) {
 	require.NotEmpty(t, product)
 
 	require.Equal(t, product.ID, productList[0].ID)
-	require.Equal(t, product.UserID, productList[0].UserID)
+	require.Equal(t, product.StoreID, productList[0].StoreID)
 	require.Equal(t, product.Category, productList[0].Category)
 	require.Equal(t, product.Price, productList[0].Price)
 	require.Equal(t, product.Cost, productList[0].Cost)
@@ -160,11 +280,12 @@ func TestGetProduct(t *testing.T) {
 }
 
 func TestUpdateProduct(t *testing.T) {
-	user := getRandomUser(t)
-	createRandomProduct(t, user)
+	store := createRandomStore(t, getRandomUser(t))
+	createRandomProduct(t, store)
 	productList, _ := testQueries.GetProductList(context.Background(), GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "",
+		Limit:         10,
 		Offset:        0,
 	})
 
@@ -202,11 +323,12 @@ func TestUpdateProduct(t *testing.T) {
 }
 
 func TestDeleteProduct(t *testing.T) {
-	user := getRandomUser(t)
-	createRandomProduct(t, user)
+	store := createRandomStore(t, getRandomUser(t))
+	createRandomProduct(t, store)
 	productList, _ := testQueries.GetProductList(context.Background(), GetProductListParams{
-		UserID:        user.ID,
+		StoreID:       store.ID,
 		Searchchosung: "",
+		Limit:         10,
 		Offset:        0,
 	})
 
@@ -217,9 +339,9 @@ func TestDeleteProduct(t *testing.T) {
 	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
-func createRandomProduct(t *testing.T, user User) {
+func createRandomProduct(t *testing.T, store Store) {
 	arg := CreateProductParams{
-		UserID:         user.ID,
+		StoreID:        store.ID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
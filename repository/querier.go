@@ -6,18 +6,70 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	AcceptStoreInvitation(ctx context.Context, id int64) (int64, error)
+	BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) error
+	BlockSession(ctx context.Context, id string) error
+	BlockSessionFamily(ctx context.Context, familyID string) error
+	BlockUserSessions(ctx context.Context, userID int64) error
+	CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (int64, error)
+	CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error
+	CreateKnownDevice(ctx context.Context, arg CreateKnownDeviceParams) error
 	CreateProduct(ctx context.Context, arg CreateProductParams) error
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
+	CreateRevokedToken(ctx context.Context, arg CreateRevokedTokenParams) error
 	CreateSession(ctx context.Context, arg CreateSessionParams) error
-	CreateUser(ctx context.Context, arg CreateUserParams) error
+	CreateStore(ctx context.Context, name string) (int64, error)
+	CreateStoreInvitation(ctx context.Context, arg CreateStoreInvitationParams) error
+	CreateStoreMember(ctx context.Context, arg CreateStoreMemberParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (int64, error)
+	CreateVerification(ctx context.Context, arg CreateVerificationParams) error
 	DeleteProduct(ctx context.Context, id int64) error
+	DeleteRecoveryCodes(ctx context.Context, userID int64) error
+	DeleteStoreMember(ctx context.Context, arg DeleteStoreMemberParams) (int64, error)
+	DeleteWithdrawnUserStores(ctx context.Context, deletedAt sql.NullTime) (int64, error)
+	EnableUserTotp(ctx context.Context, id int64) error
+	GetActiveSessionList(ctx context.Context, userID int64) ([]Session, error)
+	GetApiKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error)
+	GetApiKeyList(ctx context.Context, userID int64) ([]ApiKey, error)
+	GetAuthEventList(ctx context.Context, arg GetAuthEventListParams) ([]AuthEvent, error)
+	GetDefaultStoreMember(ctx context.Context, userID int64) (StoreMember, error)
+	GetKnownDeviceList(ctx context.Context, userID int64) ([]KnownDevice, error)
+	GetLatestVerification(ctx context.Context, arg GetLatestVerificationParams) (Verification, error)
+	GetPendingStoreInvitationList(ctx context.Context, phoneNumber string) ([]StoreInvitation, error)
 	GetProduct(ctx context.Context, id int64) (Product, error)
+	GetProductCount(ctx context.Context, arg GetProductCountParams) (int64, error)
 	GetProductList(ctx context.Context, arg GetProductListParams) ([]Product, error)
+	GetProductListByCursor(ctx context.Context, arg GetProductListByCursorParams) ([]Product, error)
+	GetRecentSessionList(ctx context.Context, arg GetRecentSessionListParams) ([]Session, error)
+	GetRevokedToken(ctx context.Context, id string) (RevokedToken, error)
 	GetSession(ctx context.Context, id string) (Session, error)
+	GetStoreInvitation(ctx context.Context, id int64) (StoreInvitation, error)
+	GetStoreList(ctx context.Context, userID int64) ([]GetStoreListRow, error)
+	GetStoreMember(ctx context.Context, arg GetStoreMemberParams) (StoreMember, error)
+	GetUnusedRecoveryCodeList(ctx context.Context, userID int64) ([]RecoveryCode, error)
 	GetUser(ctx context.Context, phoneNumber string) (User, error)
+	GetUserByID(ctx context.Context, id int64) (User, error)
+	IncreaseVerificationAttempt(ctx context.Context, id int64) error
+	PurgeRevokedTokens(ctx context.Context) (int64, error)
+	PurgeSessions(ctx context.Context, blockedAt sql.NullTime) (int64, error)
+	PurgeWithdrawnUsers(ctx context.Context, deletedAt sql.NullTime) (int64, error)
+	RestoreUser(ctx context.Context, id int64) error
+	RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (int64, error)
+	RotateSession(ctx context.Context, id string) (int64, error)
+	UpdateApiKeyLastUsedAt(ctx context.Context, id int64) error
 	UpdateProduct(ctx context.Context, arg UpdateProductParams) error
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error
+	UpdateUserTotpLastStep(ctx context.Context, arg UpdateUserTotpLastStepParams) (int64, error)
+	UpdateUserTotpSecret(ctx context.Context, arg UpdateUserTotpSecretParams) error
+	UseRecoveryCode(ctx context.Context, id int64) (int64, error)
+	UseVerification(ctx context.Context, id int64) (int64, error)
+	WithdrawUser(ctx context.Context, arg WithdrawUserParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRecoveryCode(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomRecoveryCode(t, user.ID)
+}
+
+func TestGetUnusedRecoveryCodeList(t *testing.T) {
+	user := getRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomRecoveryCode(t, user.ID)
+	}
+
+	recoveryCodeList, err := testQueries.GetUnusedRecoveryCodeList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, recoveryCodeList, 3)
+	for _, recoveryCode := range recoveryCodeList {
+		require.Equal(t, recoveryCode.UserID, user.ID)
+		require.False(t, recoveryCode.IsUsed)
+	}
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomRecoveryCode(t, user.ID)
+
+	recoveryCodeList, err := testQueries.GetUnusedRecoveryCodeList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, recoveryCodeList, 1)
+
+	rows, err := testQueries.UseRecoveryCode(context.Background(), recoveryCodeList[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	// 이미 사용한 복구 코드는 다시 사용할 수 없음
+	rows, err = testQueries.UseRecoveryCode(context.Background(), recoveryCodeList[0].ID)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	recoveryCodeList, err = testQueries.GetUnusedRecoveryCodeList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, recoveryCodeList)
+}
+
+func TestDeleteRecoveryCodes(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomRecoveryCode(t, user.ID)
+	createRandomRecoveryCode(t, user.ID)
+
+	err := testQueries.DeleteRecoveryCodes(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	recoveryCodeList, err := testQueries.GetUnusedRecoveryCodeList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, recoveryCodeList)
+}
+
+func createRandomRecoveryCode(t *testing.T, userID int64) string {
+	code := util.CreateRandomString(10)
+	hashedCode, _ := testPasswordHasher.HashPassword(code)
+
+	err := testQueries.CreateRecoveryCode(context.Background(), CreateRecoveryCodeParams{
+		UserID:     userID,
+		HashedCode: hashedCode,
+	})
+	require.NoError(t, err)
+
+	return code
+}
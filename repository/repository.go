@@ -1,11 +1,16 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 )
 
 type Repository interface {
 	Querier
+	PurgeWithdrawnUsersTx(ctx context.Context, deletedAt sql.NullTime) (int64, error)
+	RotateSessionTx(ctx context.Context, id string, arg CreateSessionParams) (int64, error)
+	RegisterTx(ctx context.Context, arg CreateUserParams, storeName string) (int64, error)
+	AcceptStoreInvitationTx(ctx context.Context, id int64, arg CreateStoreMemberParams) (int64, error)
 }
 
 type repository struct {
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRevokedToken(t *testing.T) {
+	createRandomRevokedToken(t, time.Now().Add(time.Minute))
+}
+
+func TestCreateRevokedTokenDuplicate(t *testing.T) {
+	revokedToken1 := createRandomRevokedToken(t, time.Now().Add(time.Minute))
+
+	// 더 늦은 만료 시각으로만 갱신
+	arg := CreateRevokedTokenParams{
+		ID:        revokedToken1.ID,
+		ExpiredAt: time.Now().Add(time.Hour),
+	}
+	err := testQueries.CreateRevokedToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	revokedToken2, err := testQueries.GetRevokedToken(context.Background(), revokedToken1.ID)
+	require.NoError(t, err)
+	require.WithinDuration(t, revokedToken2.ExpiredAt, arg.ExpiredAt, time.Second)
+
+	arg.ExpiredAt = time.Now().Add(time.Minute)
+	err = testQueries.CreateRevokedToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	revokedToken3, err := testQueries.GetRevokedToken(context.Background(), revokedToken1.ID)
+	require.NoError(t, err)
+	require.WithinDuration(t, revokedToken3.ExpiredAt, revokedToken2.ExpiredAt, time.Second)
+}
+
+func TestGetRevokedToken(t *testing.T) {
+	revokedToken := createRandomRevokedToken(t, time.Now().Add(-time.Minute))
+
+	// 만료된 기록은 조회되지 않음
+	_, err := testQueries.GetRevokedToken(context.Background(), revokedToken.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestPurgeRevokedTokens(t *testing.T) {
+	createRandomRevokedToken(t, time.Now().Add(-time.Minute))
+	revokedToken := createRandomRevokedToken(t, time.Now().Add(time.Minute))
+
+	count, err := testQueries.PurgeRevokedTokens(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(1))
+
+	// 만료되지 않은 기록은 유지
+	_, err = testQueries.GetRevokedToken(context.Background(), revokedToken.ID)
+	require.NoError(t, err)
+}
+
+func createRandomRevokedToken(t *testing.T, expiredAt time.Time) RevokedToken {
+	arg := CreateRevokedTokenParams{
+		ID:        uuid.NewString(),
+		ExpiredAt: expiredAt,
+	}
+
+	err := testQueries.CreateRevokedToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	return RevokedToken{
+		ID:        arg.ID,
+		ExpiredAt: arg.ExpiredAt,
+	}
+}
@@ -7,26 +7,87 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
+const blockOtherSessions = `-- name: BlockOtherSessions :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE user_id = ?
+  AND family_id != ?
+  AND is_blocked = false
+`
+
+type BlockOtherSessionsParams struct {
+	UserID   int64  `json:"user_id"`
+	FamilyID string `json:"family_id"`
+}
+
+func (q *Queries) BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) error {
+	_, err := q.db.ExecContext(ctx, blockOtherSessions, arg.UserID, arg.FamilyID)
+	return err
+}
+
+const blockSession = `-- name: BlockSession :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE id = ?
+  AND is_blocked = false
+`
+
+func (q *Queries) BlockSession(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, blockSession, id)
+	return err
+}
+
+const blockSessionFamily = `-- name: BlockSessionFamily :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE family_id = ?
+  AND is_blocked = false
+`
+
+func (q *Queries) BlockSessionFamily(ctx context.Context, familyID string) error {
+	_, err := q.db.ExecContext(ctx, blockSessionFamily, familyID)
+	return err
+}
+
+const blockUserSessions = `-- name: BlockUserSessions :exec
+UPDATE session
+SET is_blocked = true,
+  blocked_at = NOW()
+WHERE user_id = ?
+  AND is_blocked = false
+`
+
+func (q *Queries) BlockUserSessions(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, blockUserSessions, userID)
+	return err
+}
+
 const createSession = `-- name: CreateSession :exec
 INSERT INTO session(
   id,
   user_id,
+  family_id,
   refresh_token,
   user_agent,
   client_ip,
   is_blocked,
   expired_at
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?
 )
 `
 
 type CreateSessionParams struct {
 	ID           string    `json:"id"`
 	UserID       int64     `json:"user_id"`
+	FamilyID     string    `json:"family_id"`
 	RefreshToken string    `json:"refresh_token"`
 	UserAgent    string    `json:"user_agent"`
 	ClientIp     string    `json:"client_ip"`
@@ -38,6 +99,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 	_, err := q.db.ExecContext(ctx, createSession,
 		arg.ID,
 		arg.UserID,
+		arg.FamilyID,
 		arg.RefreshToken,
 		arg.UserAgent,
 		arg.ClientIp,
@@ -47,9 +109,104 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 	return err
 }
 
+const getActiveSessionList = `-- name: GetActiveSessionList :many
+SELECT
+  id, user_id, family_id, refresh_token, user_agent, client_ip, is_blocked, is_rotated, blocked_at, expired_at, created_at
+FROM session
+WHERE user_id = ?
+  AND is_blocked = false
+  AND is_rotated = false
+  AND expired_at > NOW()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetActiveSessionList(ctx context.Context, userID int64) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveSessionList, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.FamilyID,
+			&i.RefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.IsRotated,
+			&i.BlockedAt,
+			&i.ExpiredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentSessionList = `-- name: GetRecentSessionList :many
+SELECT
+  id, user_id, family_id, refresh_token, user_agent, client_ip, is_blocked, is_rotated, blocked_at, expired_at, created_at
+FROM session
+WHERE user_id = ?
+  AND created_at > ?
+ORDER BY created_at DESC
+`
+
+type GetRecentSessionListParams struct {
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) GetRecentSessionList(ctx context.Context, arg GetRecentSessionListParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentSessionList, arg.UserID, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.FamilyID,
+			&i.RefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.IsRotated,
+			&i.BlockedAt,
+			&i.ExpiredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSession = `-- name: GetSession :one
 SELECT
-  id, user_id, refresh_token, user_agent, client_ip, is_blocked, expired_at, created_at
+  id, user_id, family_id, refresh_token, user_agent, client_ip, is_blocked, is_rotated, blocked_at, expired_at, created_at
 FROM session
 WHERE id = ?
 `
@@ -60,12 +217,45 @@ func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
+		&i.FamilyID,
 		&i.RefreshToken,
 		&i.UserAgent,
 		&i.ClientIp,
 		&i.IsBlocked,
+		&i.IsRotated,
+		&i.BlockedAt,
 		&i.ExpiredAt,
 		&i.CreatedAt,
 	)
 	return i, err
 }
+
+const purgeSessions = `-- name: PurgeSessions :execrows
+DELETE FROM session
+WHERE expired_at < NOW()
+  OR blocked_at < ?
+`
+
+func (q *Queries) PurgeSessions(ctx context.Context, blockedAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeSessions, blockedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const rotateSession = `-- name: RotateSession :execrows
+UPDATE session
+SET is_rotated = true
+WHERE id = ?
+  AND is_rotated = false
+  AND is_blocked = false
+`
+
+func (q *Queries) RotateSession(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, rotateSession, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
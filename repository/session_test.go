@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"encoding/binary"
 	"math/rand"
 	"net"
@@ -9,7 +10,7 @@ import (
 	"time"
 
 	"github.com/corpix/uarand"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/util/token"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,19 +43,202 @@ func TestGetSesssion(t *testing.T) {
 
 	require.Equal(t, session.ID, refreshPayload.ID)
 	require.Equal(t, session.UserID, user.ID)
+	require.Equal(t, session.FamilyID, refreshPayload.ID)
 	require.Equal(t, session.RefreshToken, refreshToken)
 	require.Equal(t, session.UserAgent, userAgent)
 	require.Equal(t, session.ClientIp, clientIp)
 	require.False(t, session.IsBlocked)
+	require.False(t, session.IsRotated)
 	require.WithinDuration(t, session.ExpiredAt, refreshPayload.ExpiredAt, time.Second)
 }
 
-func createRandomSession(t *testing.T, user User) (string, *jwt.Payload) {
-	refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+func TestBlockSession(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload := createRandomSession(t, user)
+
+	err := testQueries.BlockSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+
+	session, err := testQueries.GetSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+	require.True(t, session.BlockedAt.Valid)
+	require.WithinDuration(t, session.BlockedAt.Time, time.Now(), time.Second)
+}
+
+func TestBlockSessionFamily(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload1 := createRandomSession(t, user)
+
+	refreshToken2, refreshPayload2, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+	err := testQueries.CreateSession(context.Background(), CreateSessionParams{
+		ID:           refreshPayload2.ID,
+		UserID:       user.ID,
+		FamilyID:     refreshPayload1.ID,
+		RefreshToken: refreshToken2,
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
+		IsBlocked:    false,
+		ExpiredAt:    refreshPayload2.ExpiredAt,
+	})
+	require.NoError(t, err)
+
+	err = testQueries.BlockSessionFamily(context.Background(), refreshPayload1.ID)
+	require.NoError(t, err)
+
+	for _, id := range []string{refreshPayload1.ID, refreshPayload2.ID} {
+		session, err := testQueries.GetSession(context.Background(), id)
+		require.NoError(t, err)
+		require.True(t, session.IsBlocked)
+	}
+}
+
+func TestRotateSession(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload := createRandomSession(t, user)
+
+	rows, err := testQueries.RotateSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	session, err := testQueries.GetSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsRotated)
+
+	rows, err = testQueries.RotateSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+}
+
+func TestGetActiveSessionList(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload1 := createRandomSession(t, user)
+	_, refreshPayload2 := createRandomSession(t, user)
+	_, refreshPayload3 := createRandomSession(t, user)
+
+	err := testQueries.BlockSession(context.Background(), refreshPayload2.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.RotateSession(context.Background(), refreshPayload3.ID)
+	require.NoError(t, err)
+
+	sessionList, err := testQueries.GetActiveSessionList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessionList, 1)
+	require.Equal(t, sessionList[0].ID, refreshPayload1.ID)
+}
+
+func TestGetRecentSessionList(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload1 := createRandomSession(t, user)
+	_, refreshPayload2 := createRandomSession(t, user)
+
+	// 차단, 교체된 세션도 포함
+	err := testQueries.BlockSession(context.Background(), refreshPayload2.ID)
+	require.NoError(t, err)
+
+	arg := GetRecentSessionListParams{
+		UserID:    user.ID,
+		CreatedAt: time.Now().Add(-time.Minute),
+	}
+
+	sessionList, err := testQueries.GetRecentSessionList(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, sessionList, 2)
+	for _, session := range sessionList {
+		require.Contains(t, []string{refreshPayload1.ID, refreshPayload2.ID}, session.ID)
+	}
+
+	// 기준 시각 이전에 생성된 세션은 제외
+	arg.CreatedAt = time.Now().Add(time.Minute)
+
+	sessionList, err = testQueries.GetRecentSessionList(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, sessionList)
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomSession(t, user)
+	createRandomSession(t, user)
+
+	err := testQueries.BlockUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	sessionList, err := testQueries.GetActiveSessionList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessionList)
+}
+
+func TestBlockOtherSessions(t *testing.T) {
+	user := getRandomUser(t)
+	_, refreshPayload1 := createRandomSession(t, user)
+	createRandomSession(t, user)
+
+	err := testQueries.BlockOtherSessions(context.Background(), BlockOtherSessionsParams{
+		UserID:   user.ID,
+		FamilyID: refreshPayload1.ID,
+	})
+	require.NoError(t, err)
+
+	sessionList, err := testQueries.GetActiveSessionList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessionList, 1)
+	require.Equal(t, sessionList[0].ID, refreshPayload1.ID)
+}
+
+func TestPurgeSessions(t *testing.T) {
+	user := getRandomUser(t)
+
+	// 만료된 세션
+	refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+	err := testQueries.CreateSession(context.Background(), CreateSessionParams{
+		ID:           refreshPayload.ID,
+		UserID:       user.ID,
+		FamilyID:     refreshPayload.ID,
+		RefreshToken: refreshToken,
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
+		ExpiredAt:    time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	expiredID := refreshPayload.ID
+
+	// 차단된 세션
+	_, blockedPayload := createRandomSession(t, user)
+	err = testQueries.BlockSession(context.Background(), blockedPayload.ID)
+	require.NoError(t, err)
+
+	// 활성 세션
+	_, activePayload := createRandomSession(t, user)
+
+	// 보관 기간이 지나지 않은 차단 세션은 유지
+	_, err = testQueries.PurgeSessions(context.Background(), sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true})
+	require.NoError(t, err)
+
+	_, err = testQueries.GetSession(context.Background(), expiredID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = testQueries.GetSession(context.Background(), blockedPayload.ID)
+	require.NoError(t, err)
+
+	// 보관 기간이 지난 차단 세션 삭제
+	rows, err := testQueries.PurgeSessions(context.Background(), sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, rows, int64(1))
+
+	_, err = testQueries.GetSession(context.Background(), blockedPayload.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = testQueries.GetSession(context.Background(), activePayload.ID)
+	require.NoError(t, err)
+}
+
+func createRandomSession(t *testing.T, user User) (string, *token.Payload) {
+	refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 	arg := CreateSessionParams{
 		ID:           refreshPayload.ID,
 		UserID:       user.ID,
+		FamilyID:     refreshPayload.ID,
 		RefreshToken: refreshToken,
 		UserAgent:    userAgent,
 		ClientIp:     clientIp,
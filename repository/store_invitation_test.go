@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStoreInvitation(t *testing.T) {
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	createRandomStoreInvitation(t, store, owner, getRandomUser(t).PhoneNumber)
+}
+
+func TestGetStoreInvitation(t *testing.T) {
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	invitation1 := createRandomStoreInvitation(t, store, owner, getRandomUser(t).PhoneNumber)
+
+	invitation2, err := testQueries.GetStoreInvitation(context.Background(), invitation1.ID)
+	require.NoError(t, err)
+	require.Equal(t, invitation2.ID, invitation1.ID)
+	require.Equal(t, invitation2.StoreID, invitation1.StoreID)
+	require.Equal(t, invitation2.PhoneNumber, invitation1.PhoneNumber)
+	require.Equal(t, invitation2.Role, invitation1.Role)
+	require.Equal(t, invitation2.InvitedBy, invitation1.InvitedBy)
+	require.False(t, invitation2.AcceptedAt.Valid)
+	require.WithinDuration(t, invitation2.ExpiredAt, invitation1.ExpiredAt, time.Second)
+}
+
+func TestAcceptStoreInvitation(t *testing.T) {
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	invitation := createRandomStoreInvitation(t, store, owner, getRandomUser(t).PhoneNumber)
+
+	rows, err := testQueries.AcceptStoreInvitation(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	// 이미 수락한 초대는 다시 수락할 수 없음
+	rows, err = testQueries.AcceptStoreInvitation(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	invitation, err = testQueries.GetStoreInvitation(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.True(t, invitation.AcceptedAt.Valid)
+
+	// 수락한 초대는 대기 목록에서 제외
+	invitationList, err := testQueries.GetPendingStoreInvitationList(context.Background(), invitation.PhoneNumber)
+	require.NoError(t, err)
+	require.Empty(t, invitationList)
+}
+
+func TestGetPendingStoreInvitationList(t *testing.T) {
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	phoneNumber := getRandomUser(t).PhoneNumber
+	for i := 0; i < 3; i++ {
+		createRandomStoreInvitation(t, store, owner, phoneNumber)
+	}
+
+	// 만료된 초대는 제외
+	err := testQueries.CreateStoreInvitation(context.Background(), CreateStoreInvitationParams{
+		StoreID:     store.ID,
+		PhoneNumber: phoneNumber,
+		Role:        StoreInvitationRoleStaff,
+		InvitedBy:   owner.ID,
+		ExpiredAt:   time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	invitationList, err := testQueries.GetPendingStoreInvitationList(context.Background(), phoneNumber)
+	require.NoError(t, err)
+	require.Len(t, invitationList, 3)
+	for _, invitation := range invitationList {
+		require.Equal(t, invitation.PhoneNumber, phoneNumber)
+		require.False(t, invitation.AcceptedAt.Valid)
+		require.True(t, invitation.ExpiredAt.After(time.Now()))
+	}
+}
+
+func createRandomStoreInvitation(t *testing.T, store Store, owner User, phoneNumber string) StoreInvitation {
+	arg := CreateStoreInvitationParams{
+		StoreID:     store.ID,
+		PhoneNumber: phoneNumber,
+		Role:        StoreInvitationRoleStaff,
+		InvitedBy:   owner.ID,
+		ExpiredAt:   time.Now().Add(time.Hour),
+	}
+
+	err := testQueries.CreateStoreInvitation(context.Background(), arg)
+	require.NoError(t, err)
+
+	invitationList, err := testQueries.GetPendingStoreInvitationList(context.Background(), phoneNumber)
+	require.NoError(t, err)
+	require.NotEmpty(t, invitationList)
+
+	invitation := invitationList[0]
+	require.NotZero(t, invitation.ID)
+	require.Equal(t, invitation.StoreID, arg.StoreID)
+	require.Equal(t, invitation.PhoneNumber, arg.PhoneNumber)
+	require.Equal(t, invitation.Role, arg.Role)
+	require.Equal(t, invitation.InvitedBy, arg.InvitedBy)
+	require.False(t, invitation.AcceptedAt.Valid)
+	require.WithinDuration(t, invitation.ExpiredAt, arg.ExpiredAt, time.Second)
+	require.NotZero(t, invitation.CreatedAt)
+
+	return invitation
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStoreMember(t *testing.T) {
+	store := createRandomStore(t, getRandomUser(t))
+	user := getRandomUser(t)
+
+	arg := CreateStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+		Role:    StoreMemberRoleStaff,
+	}
+
+	err := testQueries.CreateStoreMember(context.Background(), arg)
+	require.NoError(t, err)
+
+	// 이미 매장 회원인 경우
+	err = testQueries.CreateStoreMember(context.Background(), arg)
+	require.Error(t, err)
+}
+
+func TestGetStoreMember(t *testing.T) {
+	user := getRandomUser(t)
+	store := createRandomStore(t, user)
+
+	member, err := testQueries.GetStoreMember(context.Background(), GetStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.StoreID, store.ID)
+	require.Equal(t, member.UserID, user.ID)
+	require.Equal(t, member.Role, StoreMemberRoleOwner)
+	require.NotZero(t, member.CreatedAt)
+
+	// 매장 회원이 아닌 경우
+	_, err = testQueries.GetStoreMember(context.Background(), GetStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  getRandomUser(t).ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestGetDefaultStoreMember(t *testing.T) {
+	user := getRandomUser(t)
+	store := createRandomStore(t, user)
+	createRandomStore(t, user)
+
+	member, err := testQueries.GetDefaultStoreMember(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, member.StoreID, store.ID)
+	require.Equal(t, member.UserID, user.ID)
+
+	// 소속 매장이 없는 경우
+	_, err = testQueries.GetDefaultStoreMember(context.Background(), getRandomUser(t).ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeleteStoreMember(t *testing.T) {
+	store := createRandomStore(t, getRandomUser(t))
+	user := getRandomUser(t)
+
+	err := testQueries.CreateStoreMember(context.Background(), CreateStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+		Role:    StoreMemberRoleStaff,
+	})
+	require.NoError(t, err)
+
+	arg := DeleteStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+	}
+
+	rows, err := testQueries.DeleteStoreMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	// 이미 삭제된 회원인 경우
+	rows, err = testQueries.DeleteStoreMember(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStore(t *testing.T) {
+	user := getRandomUser(t)
+	createRandomStore(t, user)
+}
+
+func TestGetStoreList(t *testing.T) {
+	user := getRandomUser(t)
+	store1 := createRandomStore(t, user)
+	store2 := createRandomStore(t, user)
+
+	storeList, err := testQueries.GetStoreList(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, storeList, 2)
+
+	require.Equal(t, storeList[0].ID, store1.ID)
+	require.Equal(t, storeList[0].Name, store1.Name)
+	require.Equal(t, storeList[0].Role, StoreMemberRoleOwner)
+	require.Equal(t, storeList[1].ID, store2.ID)
+	require.Equal(t, storeList[1].Name, store2.Name)
+	require.Equal(t, storeList[1].Role, StoreMemberRoleOwner)
+}
+
+func createRandomStore(t *testing.T, user User) Store {
+	name := util.CreateRandomString(10)
+
+	storeID, err := testQueries.CreateStore(context.Background(), name)
+	require.NoError(t, err)
+	require.NotZero(t, storeID)
+
+	err = testQueries.CreateStoreMember(context.Background(), CreateStoreMemberParams{
+		StoreID: storeID,
+		UserID:  user.ID,
+		Role:    StoreMemberRoleOwner,
+	})
+	require.NoError(t, err)
+
+	storeList, err := testQueries.GetStoreList(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	for _, store := range storeList {
+		if store.ID == storeID {
+			require.Equal(t, store.Name, name)
+			require.NotZero(t, store.CreatedAt)
+
+			return Store{ID: store.ID, Name: store.Name, CreatedAt: store.CreatedAt}
+		}
+	}
+
+	t.Fatal("created store is not in store list")
+	return Store{}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// 트랜잭션 실행 함수
+// fn이 에러를 반환하면 롤백
+func (repository *repository) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(New(tx))
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// 유예 기간이 지난 탈퇴 회원 삭제 트랜잭션
+// 탈퇴 회원만 점주인 매장을 먼저 삭제 (상품, 초대는 ON DELETE CASCADE로 함께 삭제)
+func (repository *repository) PurgeWithdrawnUsersTx(ctx context.Context, deletedAt sql.NullTime) (count int64, err error) {
+	err = repository.execTx(ctx, func(q *Queries) error {
+		_, err := q.DeleteWithdrawnUserStores(ctx, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		count, err = q.PurgeWithdrawnUsers(ctx, deletedAt)
+		return err
+	})
+
+	return
+}
+
+// 세션 교체 트랜잭션
+// 기존 세션 교체와 새 세션 저장을 함께 처리 (새 세션 저장에 실패하면 교체도 롤백)
+// 이미 교체된 세션이면 새 세션을 저장하지 않고 0 반환
+func (repository *repository) RotateSessionTx(ctx context.Context, id string, arg CreateSessionParams) (rows int64, err error) {
+	err = repository.execTx(ctx, func(q *Queries) error {
+		rows, err = q.RotateSession(ctx, id)
+		if err != nil || rows == 0 {
+			return err
+		}
+
+		return q.CreateSession(ctx, arg)
+	})
+
+	return
+}
+
+// 회원 가입 트랜잭션
+// 회원 생성, 기본 매장 생성, 점주 등록을 함께 처리 (하나라도 실패하면 회원 생성도 롤백)
+func (repository *repository) RegisterTx(ctx context.Context, arg CreateUserParams, storeName string) (userID int64, err error) {
+	err = repository.execTx(ctx, func(q *Queries) error {
+		userID, err = q.CreateUser(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		storeID, err := q.CreateStore(ctx, storeName)
+		if err != nil {
+			return err
+		}
+
+		return q.CreateStoreMember(ctx, CreateStoreMemberParams{
+			StoreID: storeID,
+			UserID:  userID,
+			Role:    StoreMemberRoleOwner,
+		})
+	})
+
+	return
+}
+
+// 매장 초대 수락 트랜잭션
+// 초대 수락 처리와 매장 회원 등록을 함께 처리 (매장 회원 등록에 실패하면 수락도 롤백)
+// 이미 수락된 초대면 매장 회원을 등록하지 않고 0 반환
+func (repository *repository) AcceptStoreInvitationTx(ctx context.Context, id int64, arg CreateStoreMemberParams) (rows int64, err error) {
+	err = repository.execTx(ctx, func(q *Queries) error {
+		rows, err = q.AcceptStoreInvitation(ctx, id)
+		if err != nil || rows == 0 {
+			return err
+		}
+
+		return q.CreateStoreMember(ctx, arg)
+	})
+
+	return
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeWithdrawnUsersTx(t *testing.T) {
+	repository := NewRepository(testDB)
+
+	// 탈퇴 회원만 점주인 매장
+	withdrawnUser := getRandomUser(t)
+	withdrawnStore := createRandomStore(t, withdrawnUser)
+	createRandomProduct(t, withdrawnStore)
+	withdrawRandomUser(t, withdrawnUser, time.Now().Add(-2*time.Hour))
+
+	// 다른 점주가 있는 매장
+	otherOwner := getRandomUser(t)
+	sharedStore := createRandomStore(t, otherOwner)
+	createRandomProduct(t, sharedStore)
+	err := testQueries.CreateStoreMember(context.Background(), CreateStoreMemberParams{
+		StoreID: sharedStore.ID,
+		UserID:  withdrawnUser.ID,
+		Role:    StoreMemberRoleOwner,
+	})
+	require.NoError(t, err)
+
+	// 유예 기간이 지나지 않은 탈퇴 회원의 매장
+	graceUser := getRandomUser(t)
+	graceStore := createRandomStore(t, graceUser)
+	createRandomProduct(t, graceStore)
+	withdrawRandomUser(t, graceUser, time.Now())
+
+	rows, err := repository.PurgeWithdrawnUsersTx(context.Background(), sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, rows, int64(1))
+
+	_, err = testQueries.GetUserByID(context.Background(), withdrawnUser.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// 매장과 상품이 함께 삭제
+	require.False(t, existsStore(t, withdrawnStore.ID))
+	require.Zero(t, countStoreProduct(t, withdrawnStore.ID))
+
+	for _, store := range []Store{sharedStore, graceStore} {
+		require.True(t, existsStore(t, store.ID))
+		require.Equal(t, int64(1), countStoreProduct(t, store.ID))
+	}
+}
+
+func TestRotateSessionTx(t *testing.T) {
+	repository := NewRepository(testDB)
+	user := getRandomUser(t)
+	_, refreshPayload := createRandomSession(t, user)
+
+	newRefreshToken, newRefreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+	arg := CreateSessionParams{
+		ID:           newRefreshPayload.ID,
+		UserID:       user.ID,
+		FamilyID:     refreshPayload.ID,
+		RefreshToken: newRefreshToken,
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
+		ExpiredAt:    newRefreshPayload.ExpiredAt,
+	}
+
+	rows, err := repository.RotateSessionTx(context.Background(), refreshPayload.ID, arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	session, err := testQueries.GetSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsRotated)
+
+	newSession, err := testQueries.GetSession(context.Background(), newRefreshPayload.ID)
+	require.NoError(t, err)
+	require.Equal(t, refreshPayload.ID, newSession.FamilyID)
+
+	// 이미 교체된 세션은 새 세션을 저장하지 않음
+	_, otherRefreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+	arg.ID = otherRefreshPayload.ID
+
+	rows, err = repository.RotateSessionTx(context.Background(), refreshPayload.ID, arg)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	_, err = testQueries.GetSession(context.Background(), otherRefreshPayload.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestRotateSessionTxRollback(t *testing.T) {
+	repository := NewRepository(testDB)
+	user := getRandomUser(t)
+	_, refreshPayload := createRandomSession(t, user)
+	_, existingPayload := createRandomSession(t, user)
+
+	// 이미 있는 세션 ID로 저장 실패
+	rows, err := repository.RotateSessionTx(context.Background(), refreshPayload.ID, CreateSessionParams{
+		ID:           existingPayload.ID,
+		UserID:       user.ID,
+		FamilyID:     refreshPayload.ID,
+		RefreshToken: util.CreateRandomString(50),
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
+		ExpiredAt:    refreshPayload.ExpiredAt,
+	})
+	require.Error(t, err)
+	require.Equal(t, int64(1), rows)
+
+	// 세션 교체도 롤백
+	session, err := testQueries.GetSession(context.Background(), refreshPayload.ID)
+	require.NoError(t, err)
+	require.False(t, session.IsRotated)
+}
+
+func TestRegisterTx(t *testing.T) {
+	repository := NewRepository(testDB)
+	phoneNumber := util.CreateRandomPhoneNumber()
+
+	userID, err := repository.RegisterTx(context.Background(), CreateUserParams{
+		PhoneNumber:    phoneNumber,
+		HashedPassword: util.CreateRandomString(60),
+	}, phoneNumber)
+	require.NoError(t, err)
+	require.NotZero(t, userID)
+
+	// 기본 매장의 점주로 등록
+	member, err := testQueries.GetDefaultStoreMember(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, member.Role, StoreMemberRoleOwner)
+
+	storeList, err := testQueries.GetStoreList(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, storeList, 1)
+	require.Equal(t, storeList[0].Name, phoneNumber)
+}
+
+func TestRegisterTxRollback(t *testing.T) {
+	repository := NewRepository(testDB)
+	phoneNumber := util.CreateRandomPhoneNumber()
+
+	// 매장명 길이 초과로 매장 생성 실패
+	_, err := repository.RegisterTx(context.Background(), CreateUserParams{
+		PhoneNumber:    phoneNumber,
+		HashedPassword: util.CreateRandomString(60),
+	}, util.CreateRandomString(101))
+	require.Error(t, err)
+
+	// 회원 생성도 롤백
+	_, err = testQueries.GetUser(context.Background(), phoneNumber)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestAcceptStoreInvitationTx(t *testing.T) {
+	repository := NewRepository(testDB)
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	user := getRandomUser(t)
+	invitation := createRandomStoreInvitation(t, store, owner, user.PhoneNumber)
+
+	arg := CreateStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+		Role:    StoreMemberRole(invitation.Role),
+	}
+
+	rows, err := repository.AcceptStoreInvitationTx(context.Background(), invitation.ID, arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	member, err := testQueries.GetStoreMember(context.Background(), GetStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.Role, arg.Role)
+
+	acceptedInvitation, err := testQueries.GetStoreInvitation(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.True(t, acceptedInvitation.AcceptedAt.Valid)
+
+	// 이미 수락된 초대는 매장 회원을 등록하지 않음
+	otherUser := getRandomUser(t)
+	arg.UserID = otherUser.ID
+
+	rows, err = repository.AcceptStoreInvitationTx(context.Background(), invitation.ID, arg)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	_, err = testQueries.GetStoreMember(context.Background(), GetStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  otherUser.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestAcceptStoreInvitationTxRollback(t *testing.T) {
+	repository := NewRepository(testDB)
+	owner := getRandomUser(t)
+	store := createRandomStore(t, owner)
+	invitation := createRandomStoreInvitation(t, store, owner, util.CreateRandomPhoneNumber())
+
+	// 이미 매장 회원인 점주로 등록 실패
+	rows, err := repository.AcceptStoreInvitationTx(context.Background(), invitation.ID, CreateStoreMemberParams{
+		StoreID: store.ID,
+		UserID:  owner.ID,
+		Role:    StoreMemberRole(invitation.Role),
+	})
+	require.Error(t, err)
+	require.Equal(t, int64(1), rows)
+
+	// 초대 수락도 롤백
+	pendingInvitation, err := testQueries.GetStoreInvitation(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.False(t, pendingInvitation.AcceptedAt.Valid)
+}
+
+func existsStore(t *testing.T, storeID int64) bool {
+	var count int64
+	err := testDB.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM store WHERE id = ?", storeID).Scan(&count)
+	require.NoError(t, err)
+
+	return count > 0
+}
+
+func countStoreProduct(t *testing.T, storeID int64) int64 {
+	count, err := testQueries.GetProductCount(context.Background(), GetProductCountParams{
+		StoreID:       storeID,
+		Searchchosung: "",
+	})
+	require.NoError(t, err)
+
+	return count
+}
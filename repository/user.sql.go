@@ -7,9 +7,10 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 )
 
-const createUser = `-- name: CreateUser :exec
+const createUser = `-- name: CreateUser :execlastid
 INSERT INTO user(
   phone_number,
   hashed_password
@@ -23,14 +24,28 @@ type CreateUserParams struct {
 	HashedPassword string `json:"hashed_password"`
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.ExecContext(ctx, createUser, arg.PhoneNumber, arg.HashedPassword)
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createUser, arg.PhoneNumber, arg.HashedPassword)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const enableUserTotp = `-- name: EnableUserTotp :exec
+UPDATE user
+SET is_totp_enabled = true
+WHERE id = ?
+`
+
+func (q *Queries) EnableUserTotp(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, enableUserTotp, id)
 	return err
 }
 
 const getUser = `-- name: GetUser :one
 SELECT
-  id, phone_number, hashed_password, created_at
+  id, phone_number, hashed_password, role, display_name, shop_name, timezone, totp_secret, is_totp_enabled, totp_last_step, created_at, deleted_at
 FROM user
 WHERE phone_number = ?
 `
@@ -42,7 +57,175 @@ func (q *Queries) GetUser(ctx context.Context, phoneNumber string) (User, error)
 		&i.ID,
 		&i.PhoneNumber,
 		&i.HashedPassword,
+		&i.Role,
+		&i.DisplayName,
+		&i.ShopName,
+		&i.Timezone,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
+		&i.CreatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT
+  id, phone_number, hashed_password, role, display_name, shop_name, timezone, totp_secret, is_totp_enabled, totp_last_step, created_at, deleted_at
+FROM user
+WHERE id = ?
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.PhoneNumber,
+		&i.HashedPassword,
+		&i.Role,
+		&i.DisplayName,
+		&i.ShopName,
+		&i.Timezone,
+		&i.TotpSecret,
+		&i.IsTotpEnabled,
+		&i.TotpLastStep,
 		&i.CreatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
+
+const purgeWithdrawnUsers = `-- name: PurgeWithdrawnUsers :execrows
+DELETE FROM user
+WHERE deleted_at < ?
+`
+
+func (q *Queries) PurgeWithdrawnUsers(ctx context.Context, deletedAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeWithdrawnUsers, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreUser = `-- name: RestoreUser :exec
+UPDATE user
+SET deleted_at = NULL
+WHERE id = ?
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, restoreUser, id)
+	return err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE user
+SET hashed_password = ?
+WHERE id = ?
+`
+
+type UpdateUserPasswordParams struct {
+	HashedPassword string `json:"hashed_password"`
+	ID             int64  `json:"id"`
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.HashedPassword, arg.ID)
+	return err
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :exec
+UPDATE user
+SET phone_number = ?, display_name = ?, shop_name = ?, timezone = ?
+WHERE id = ?
+`
+
+type UpdateUserProfileParams struct {
+	PhoneNumber string         `json:"phone_number"`
+	DisplayName sql.NullString `json:"display_name"`
+	ShopName    sql.NullString `json:"shop_name"`
+	Timezone    string         `json:"timezone"`
+	ID          int64          `json:"id"`
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserProfile,
+		arg.PhoneNumber,
+		arg.DisplayName,
+		arg.ShopName,
+		arg.Timezone,
+		arg.ID,
+	)
+	return err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE user
+SET role = ?
+WHERE id = ?
+`
+
+type UpdateUserRoleParams struct {
+	Role UserRole `json:"role"`
+	ID   int64    `json:"id"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserRole, arg.Role, arg.ID)
+	return err
+}
+
+const updateUserTotpLastStep = `-- name: UpdateUserTotpLastStep :execrows
+UPDATE user
+SET totp_last_step = ?
+WHERE id = ?
+  AND totp_last_step < ?
+`
+
+type UpdateUserTotpLastStepParams struct {
+	TotpLastStep int64 `json:"totp_last_step"`
+	ID           int64 `json:"id"`
+}
+
+func (q *Queries) UpdateUserTotpLastStep(ctx context.Context, arg UpdateUserTotpLastStepParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserTotpLastStep, arg.TotpLastStep, arg.ID, arg.TotpLastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateUserTotpSecret = `-- name: UpdateUserTotpSecret :exec
+UPDATE user
+SET totp_secret = ?, is_totp_enabled = false
+WHERE id = ?
+`
+
+type UpdateUserTotpSecretParams struct {
+	TotpSecret sql.NullString `json:"totp_secret"`
+	ID         int64          `json:"id"`
+}
+
+func (q *Queries) UpdateUserTotpSecret(ctx context.Context, arg UpdateUserTotpSecretParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserTotpSecret, arg.TotpSecret, arg.ID)
+	return err
+}
+
+const withdrawUser = `-- name: WithdrawUser :exec
+UPDATE user
+SET deleted_at = ?
+WHERE id = ?
+`
+
+type WithdrawUserParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        int64        `json:"id"`
+}
+
+func (q *Queries) WithdrawUser(ctx context.Context, arg WithdrawUserParams) error {
+	_, err := q.db.ExecContext(ctx, withdrawUser, arg.DeletedAt, arg.ID)
+	return err
+}
@@ -2,10 +2,11 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/gitaepark/pha/util"
-	"github.com/gitaepark/pha/util/bcrypt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,18 +18,195 @@ func TestGetUser(t *testing.T) {
 	getRandomUser(t)
 }
 
+func TestGetUserByID(t *testing.T) {
+	user1 := getRandomUser(t)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.Equal(t, user2, user1)
+}
+
+func TestUpdateUserPassword(t *testing.T) {
+	user1 := getRandomUser(t)
+	password := util.CreateRandomPassword()
+	hashedPassword, _ := testPasswordHasher.HashPassword(password)
+
+	err := testQueries.UpdateUserPassword(context.Background(), UpdateUserPasswordParams{
+		HashedPassword: hashedPassword,
+		ID:             user1.ID,
+	})
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.NoError(t, testPasswordHasher.CheckPassword(password, user2.HashedPassword))
+}
+
+func TestUpdateUserProfile(t *testing.T) {
+	user1 := getRandomUser(t)
+
+	arg := UpdateUserProfileParams{
+		PhoneNumber: util.CreateRandomPhoneNumber(),
+		DisplayName: sql.NullString{String: util.CreateRandomString(10), Valid: true},
+		ShopName:    sql.NullString{String: util.CreateRandomString(10), Valid: true},
+		Timezone:    "America/New_York",
+		ID:          user1.ID,
+	}
+
+	err := testQueries.UpdateUserProfile(context.Background(), arg)
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.Equal(t, user2.PhoneNumber, arg.PhoneNumber)
+	require.Equal(t, user2.DisplayName, arg.DisplayName)
+	require.Equal(t, user2.ShopName, arg.ShopName)
+	require.Equal(t, user2.Timezone, arg.Timezone)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	user1 := getRandomUser(t)
+
+	err := testQueries.UpdateUserRole(context.Background(), UpdateUserRoleParams{
+		Role: UserRoleStaff,
+		ID:   user1.ID,
+	})
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.Equal(t, user2.Role, UserRoleStaff)
+}
+
+func TestWithdrawUser(t *testing.T) {
+	user1 := getRandomUser(t)
+	require.False(t, user1.DeletedAt.Valid)
+
+	withdrawRandomUser(t, user1, time.Now())
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.True(t, user2.DeletedAt.Valid)
+	require.WithinDuration(t, user2.DeletedAt.Time, time.Now(), time.Second)
+}
+
+func TestRestoreUser(t *testing.T) {
+	user1 := getRandomUser(t)
+	withdrawRandomUser(t, user1, time.Now())
+
+	err := testQueries.RestoreUser(context.Background(), user1.ID)
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.False(t, user2.DeletedAt.Valid)
+}
+
+func TestPurgeWithdrawnUsers(t *testing.T) {
+	user1 := getRandomUser(t)
+	withdrawRandomUser(t, user1, time.Now().Add(-2*time.Hour))
+	user2 := getRandomUser(t)
+	withdrawRandomUser(t, user2, time.Now())
+	user3 := getRandomUser(t)
+
+	rows, err := testQueries.PurgeWithdrawnUsers(context.Background(), sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, rows, int64(1))
+
+	// 유예 기간이 지난 회원만 삭제
+	_, err = testQueries.GetUserByID(context.Background(), user1.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	for _, user := range []User{user2, user3} {
+		_, err = testQueries.GetUserByID(context.Background(), user.ID)
+		require.NoError(t, err)
+	}
+}
+
+func TestUpdateUserTotpSecret(t *testing.T) {
+	user1 := getRandomUser(t)
+	require.False(t, user1.TotpSecret.Valid)
+	require.False(t, user1.IsTotpEnabled)
+
+	secret := util.CreateRandomString(32)
+	err := testQueries.UpdateUserTotpSecret(context.Background(), UpdateUserTotpSecretParams{
+		TotpSecret: sql.NullString{String: secret, Valid: true},
+		ID:         user1.ID,
+	})
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.Equal(t, user2.TotpSecret.String, secret)
+	require.False(t, user2.IsTotpEnabled)
+}
+
+func TestEnableUserTotp(t *testing.T) {
+	user1 := getRandomUser(t)
+	err := testQueries.UpdateUserTotpSecret(context.Background(), UpdateUserTotpSecretParams{
+		TotpSecret: sql.NullString{String: util.CreateRandomString(32), Valid: true},
+		ID:         user1.ID,
+	})
+	require.NoError(t, err)
+
+	err = testQueries.EnableUserTotp(context.Background(), user1.ID)
+	require.NoError(t, err)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.True(t, user2.IsTotpEnabled)
+
+	// 비밀키를 다시 등록하면 비활성화
+	err = testQueries.UpdateUserTotpSecret(context.Background(), UpdateUserTotpSecretParams{
+		TotpSecret: sql.NullString{String: util.CreateRandomString(32), Valid: true},
+		ID:         user1.ID,
+	})
+	require.NoError(t, err)
+
+	user3, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.False(t, user3.IsTotpEnabled)
+}
+
+func TestUpdateUserTotpLastStep(t *testing.T) {
+	user1 := getRandomUser(t)
+	step := time.Now().Unix() / 30
+
+	rows, err := testQueries.UpdateUserTotpLastStep(context.Background(), UpdateUserTotpLastStepParams{
+		TotpLastStep: step,
+		ID:           user1.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	user2, err := testQueries.GetUserByID(context.Background(), user1.ID)
+	require.NoError(t, err)
+	require.Equal(t, step, user2.TotpLastStep)
+
+	// 같은 스텝이나 이전 스텝은 갱신하지 않음
+	for _, usedStep := range []int64{step, step - 1} {
+		rows, err = testQueries.UpdateUserTotpLastStep(context.Background(), UpdateUserTotpLastStepParams{
+			TotpLastStep: usedStep,
+			ID:           user1.ID,
+		})
+		require.NoError(t, err)
+		require.Zero(t, rows)
+	}
+}
+
 func createRandomUser(t *testing.T) (string, string) {
 	phoneNumber := util.CreateRandomPhoneNumber()
 	password := util.CreateRandomString(10)
-	hashedPassword, _ := bcrypt.HashPassword(password)
+	hashedPassword, _ := testPasswordHasher.HashPassword(password)
 
 	arg := CreateUserParams{
 		PhoneNumber:    phoneNumber,
 		HashedPassword: hashedPassword,
 	}
 
-	err := testQueries.CreateUser(context.Background(), arg)
+	userID, err := testQueries.CreateUser(context.Background(), arg)
 	require.NoError(t, err)
+	require.NotZero(t, userID)
 
 	return phoneNumber, password
 }
@@ -42,8 +220,18 @@ func getRandomUser(t *testing.T) User {
 
 	require.NotZero(t, user.ID)
 	require.Equal(t, user.PhoneNumber, phoneNumber)
-	require.NoError(t, bcrypt.CheckPassword(password, user.HashedPassword))
+	require.NoError(t, testPasswordHasher.CheckPassword(password, user.HashedPassword))
+	require.Equal(t, user.Role, UserRoleOwner)
+	require.Equal(t, user.Timezone, "Asia/Seoul")
 	require.NotZero(t, user.CreatedAt)
 
 	return user
 }
+
+func withdrawRandomUser(t *testing.T, user User, deletedAt time.Time) {
+	err := testQueries.WithdrawUser(context.Background(), WithdrawUserParams{
+		DeletedAt: sql.NullTime{Time: deletedAt, Valid: true},
+		ID:        user.ID,
+	})
+	require.NoError(t, err)
+}
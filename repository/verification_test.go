@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateVerification(t *testing.T) {
+	createRandomVerification(t, util.CreateRandomPhoneNumber(), VerificationPurposeRegister)
+}
+
+func TestGetLatestVerification(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+	createRandomVerification(t, phoneNumber, VerificationPurposeRegister)
+	verification1 := getRecentVerification(t, phoneNumber, VerificationPurposeRegister)
+	code := createRandomVerification(t, phoneNumber, VerificationPurposeRegister)
+
+	// 마지막으로 생성한 인증번호 조회
+	verification2 := getRecentVerification(t, phoneNumber, VerificationPurposeRegister)
+	require.Greater(t, verification2.ID, verification1.ID)
+	require.NoError(t, testPasswordHasher.CheckPassword(code, verification2.HashedCode))
+
+	// 다른 목적의 인증번호는 조회되지 않음
+	_, err := testQueries.GetLatestVerification(context.Background(), GetLatestVerificationParams{
+		PhoneNumber: phoneNumber,
+		Purpose:     VerificationPurposePasswordReset,
+	})
+	require.Error(t, err)
+}
+
+func TestIncreaseVerificationAttempt(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+	createRandomVerification(t, phoneNumber, VerificationPurposePasswordReset)
+	verification1 := getRecentVerification(t, phoneNumber, VerificationPurposePasswordReset)
+
+	err := testQueries.IncreaseVerificationAttempt(context.Background(), verification1.ID)
+	require.NoError(t, err)
+
+	verification2 := getRecentVerification(t, phoneNumber, VerificationPurposePasswordReset)
+	require.Equal(t, verification2.AttemptCount, verification1.AttemptCount+1)
+}
+
+func TestUseVerification(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+	createRandomVerification(t, phoneNumber, VerificationPurposeRegister)
+	verification1 := getRecentVerification(t, phoneNumber, VerificationPurposeRegister)
+
+	rows, err := testQueries.UseVerification(context.Background(), verification1.ID)
+	require.NoError(t, err)
+	require.Equal(t, rows, int64(1))
+
+	verification2 := getRecentVerification(t, phoneNumber, VerificationPurposeRegister)
+	require.True(t, verification2.IsUsed)
+
+	// 이미 사용한 인증번호는 다시 사용할 수 없음
+	rows, err = testQueries.UseVerification(context.Background(), verification1.ID)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+}
+
+func createRandomVerification(t *testing.T, phoneNumber string, purpose VerificationPurpose) string {
+	code := "123456"
+	hashedCode, _ := testPasswordHasher.HashPassword(code)
+
+	arg := CreateVerificationParams{
+		PhoneNumber: phoneNumber,
+		Purpose:     purpose,
+		HashedCode:  hashedCode,
+		ExpiredAt:   time.Now().Add(3 * time.Minute),
+	}
+
+	err := testQueries.CreateVerification(context.Background(), arg)
+	require.NoError(t, err)
+
+	return code
+}
+
+func getRecentVerification(t *testing.T, phoneNumber string, purpose VerificationPurpose) Verification {
+	verification, err := testQueries.GetLatestVerification(context.Background(), GetLatestVerificationParams{
+		PhoneNumber: phoneNumber,
+		Purpose:     purpose,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, verification)
+
+	require.NotZero(t, verification.ID)
+	require.Equal(t, verification.PhoneNumber, phoneNumber)
+	require.Equal(t, verification.Purpose, purpose)
+	require.False(t, verification.ExpiredAt.IsZero())
+	require.NotZero(t, verification.CreatedAt)
+
+	return verification
+}
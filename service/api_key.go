@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/rs/zerolog/log"
+)
+
+// API 키 형식: pha_<prefix>_<secret>
+const (
+	apiKeyIdentifier   = "pha"
+	apiKeyPrefixLength = 8
+	apiKeySecretLength = 32
+)
+
+type CreateApiKeyParams struct {
+	UserID int64
+	dto.CreateApiKeyRequestBody
+}
+
+// API 키 발급 로직
+// 원본 키는 응답으로만 전달하고 해시와 prefix만 저장
+func (service *service) CreateApiKey(ctx context.Context, params CreateApiKeyParams) (result dto.CreateApiKeyResponse, cErr CustomErr) {
+	key, prefix, err := generateApiKey()
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	scopes := normalizeApiKeyScopes(params.Scopes)
+
+	arg := repository.CreateApiKeyParams{
+		UserID:    params.UserID,
+		Name:      params.Name,
+		Prefix:    prefix,
+		HashedKey: hashApiKey(key),
+		Scopes:    strings.Join(scopes, ","),
+	}
+	if params.ExpiresInDays > 0 {
+		arg.ExpiredAt = sql.NullTime{Time: time.Now().AddDate(0, 0, int(params.ExpiresInDays)), Valid: true}
+	}
+
+	// API 키 생성
+	apiKeyID, err := service.repository.CreateApiKey(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.CreateApiKeyResponse{
+		ID:     apiKeyID,
+		Key:    key,
+		Prefix: prefix,
+		Scopes: scopes,
+	}
+	if arg.ExpiredAt.Valid {
+		result.ExpiredAt = &arg.ExpiredAt.Time
+	}
+
+	return
+}
+
+type GetApiKeyListParams struct {
+	UserID int64
+}
+
+// API 키 목록 조회 로직 (폐기된 키 제외)
+func (service *service) GetApiKeyList(ctx context.Context, params GetApiKeyListParams) (result dto.GetApiKeyListResponse, cErr CustomErr) {
+	apiKeyList, err := service.repository.GetApiKeyList(ctx, params.UserID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetApiKeyListResponse(apiKeyList)
+	return
+}
+
+type RevokeApiKeyParams struct {
+	UserID int64
+	dto.RevokeApiKeyRequestPath
+}
+
+// API 키 폐기 로직
+func (service *service) RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (cErr CustomErr) {
+	arg := repository.RevokeApiKeyParams{
+		ID:     params.ID,
+		UserID: params.UserID,
+	}
+
+	// 본인의 폐기되지 않은 키만 폐기
+	rows, err := service.repository.RevokeApiKey(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	if rows == 0 {
+		cErr = errNotFoundApiKey
+		return
+	}
+
+	return
+}
+
+// API 키 검증 로직
+// 검증된 키는 access 토큰과 같은 형태의 payload로 변환
+func (service *service) VerifyApiKey(ctx context.Context, key string) (payload *token.Payload, cErr CustomErr) {
+	prefix, ok := parseApiKey(key)
+	if !ok {
+		cErr = errInvalidApiKey
+		return
+	}
+
+	// API 키 검색
+	apiKey, err := service.repository.GetApiKeyByPrefix(ctx, prefix)
+	if err != nil {
+		// 해당 prefix의 키가 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errInvalidApiKey
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 키 해시 비교
+	if subtle.ConstantTimeCompare([]byte(hashApiKey(key)), []byte(apiKey.HashedKey)) != 1 {
+		cErr = errInvalidApiKey
+		return
+	}
+
+	// 폐기된 키인 경우
+	if apiKey.RevokedAt.Valid {
+		cErr = errRevokedApiKey
+		return
+	}
+
+	// 만료된 키인 경우
+	if apiKey.ExpiredAt.Valid && time.Now().After(apiKey.ExpiredAt.Time) {
+		cErr = errExpiredApiKey
+		return
+	}
+
+	// 키 발급 회원 검색 (역할은 현재 회원 기준으로 적용)
+	user, err := service.repository.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			cErr = errInvalidApiKey
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 탈퇴한 회원의 키인 경우
+	if user.DeletedAt.Valid {
+		cErr = errInvalidApiKey
+		return
+	}
+
+	// 마지막 사용 시간 갱신 (실패해도 요청은 처리)
+	err = service.repository.UpdateApiKeyLastUsedAt(ctx, apiKey.ID)
+	if err != nil {
+		log.Error().Err(err).Int64("api_key_id", apiKey.ID).Msg("cannot update api key last used at")
+	}
+
+	payload = &token.Payload{
+		UserID:   user.ID,
+		Role:     string(user.Role),
+		ApiKeyID: apiKey.ID,
+		Scopes:   strings.Split(apiKey.Scopes, ","),
+		IssuedAt: apiKey.CreatedAt,
+	}
+	if apiKey.ExpiredAt.Valid {
+		payload.ExpiredAt = apiKey.ExpiredAt.Time
+	}
+
+	return
+}
+
+// API 키 생성 함수 (소문자, 숫자)
+func generateApiKey() (key string, prefix string, err error) {
+	buf := make([]byte, apiKeyPrefixLength+apiKeySecretLength)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return
+	}
+
+	encoded := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))
+	prefix = encoded[:apiKeyPrefixLength]
+	secret := encoded[apiKeyPrefixLength : apiKeyPrefixLength+apiKeySecretLength]
+	key = apiKeyIdentifier + "_" + prefix + "_" + secret
+
+	return
+}
+
+// API 키에서 prefix 추출 함수
+func parseApiKey(key string) (prefix string, ok bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != apiKeyIdentifier || len(parts[1]) != apiKeyPrefixLength || len(parts[2]) != apiKeySecretLength {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// API 키 해시 함수
+// 충분히 긴 무작위 키이므로 비밀번호 해시 대신 sha256 사용
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// 권한 범위 중복 제거 및 정렬 함수
+func normalizeApiKeyScopes(scopes []string) []string {
+	set := make(map[string]struct{}, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := set[scope]; ok {
+			continue
+		}
+		set[scope] = struct{}{}
+		normalized = append(normalized, scope)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
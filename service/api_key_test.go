@@ -0,0 +1,497 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	user, _ := createRandomUser(t)
+	apiKeyID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		params        CreateApiKeyParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.CreateApiKeyResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: CreateApiKeyParams{
+				UserID: user.ID,
+				CreateApiKeyRequestBody: dto.CreateApiKeyRequestBody{
+					Name:          util.CreateRandomString(10),
+					Scopes:        []string{dto.ApiKeyScopeProductsWrite, dto.ApiKeyScopeProductsRead, dto.ApiKeyScopeProductsRead},
+					ExpiresInDays: 30,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.CreateApiKeyParams) (int64, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.Len(t, arg.Prefix, apiKeyPrefixLength)
+						require.Len(t, arg.HashedKey, 64)
+						require.Equal(t, "products:read,products:write", arg.Scopes)
+						require.True(t, arg.ExpiredAt.Valid)
+						require.WithinDuration(t, time.Now().AddDate(0, 0, 30), arg.ExpiredAt.Time, time.Second)
+						return apiKeyID, nil
+					})
+			},
+			checkResponse: func(result dto.CreateApiKeyResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, apiKeyID, result.ID)
+				require.True(t, strings.HasPrefix(result.Key, apiKeyIdentifier+"_"+result.Prefix+"_"))
+				prefix, ok := parseApiKey(result.Key)
+				require.True(t, ok)
+				require.Equal(t, result.Prefix, prefix)
+				require.Equal(t, []string{dto.ApiKeyScopeProductsRead, dto.ApiKeyScopeProductsWrite}, result.Scopes)
+				require.NotNil(t, result.ExpiredAt)
+			},
+		},
+		{
+			name: "만료 기간 없음",
+			params: CreateApiKeyParams{
+				UserID: user.ID,
+				CreateApiKeyRequestBody: dto.CreateApiKeyRequestBody{
+					Name:   util.CreateRandomString(10),
+					Scopes: []string{dto.ApiKeyScopeProductsRead},
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.CreateApiKeyParams) (int64, error) {
+						require.False(t, arg.ExpiredAt.Valid)
+						return apiKeyID, nil
+					})
+			},
+			checkResponse: func(result dto.CreateApiKeyResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Nil(t, result.ExpiredAt)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: CreateApiKeyParams{
+				UserID: user.ID,
+				CreateApiKeyRequestBody: dto.CreateApiKeyRequestBody{
+					Name:   util.CreateRandomString(10),
+					Scopes: []string{dto.ApiKeyScopeProductsRead},
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.CreateApiKeyResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.CreateApiKey(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestGetApiKeyList(t *testing.T) {
+	user, _ := createRandomUser(t)
+	var apiKeyList []repository.ApiKey
+	for i := 0; i < 3; i++ {
+		apiKey, _ := createRandomApiKey(t, user)
+		apiKeyList = append(apiKeyList, apiKey)
+	}
+
+	testCases := []struct {
+		name          string
+		params        GetApiKeyListParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetApiKeyListResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: GetApiKeyListParams{
+				UserID: user.ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(apiKeyList, nil)
+			},
+			checkResponse: func(result dto.GetApiKeyListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, len(apiKeyList))
+				for i, apiKey := range result.List {
+					require.Equal(t, apiKeyList[i].ID, apiKey.ID)
+					require.Equal(t, apiKeyList[i].Prefix, apiKey.Prefix)
+					require.Equal(t, []string{dto.ApiKeyScopeProductsRead}, apiKey.Scopes)
+				}
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: GetApiKeyListParams{
+				UserID: user.ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.ApiKey{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetApiKeyListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetApiKeyList(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	user, _ := createRandomUser(t)
+	apiKey, _ := createRandomApiKey(t, user)
+
+	testCases := []struct {
+		name          string
+		params        RevokeApiKeyParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: RevokeApiKeyParams{
+				UserID:                  user.ID,
+				RevokeApiKeyRequestPath: dto.RevokeApiKeyRequestPath{ID: apiKey.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.RevokeApiKeyParams{
+					ID:     apiKey.ID,
+					UserID: user.ID,
+				}
+
+				mockRepository.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "존재하지 않는 API 키",
+			params: RevokeApiKeyParams{
+				UserID:                  user.ID,
+				RevokeApiKeyRequestPath: dto.RevokeApiKeyRequestPath{ID: apiKey.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundApiKey)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: RevokeApiKeyParams{
+				UserID:                  user.ID,
+				RevokeApiKeyRequestPath: dto.RevokeApiKeyRequestPath{ID: apiKey.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					RevokeApiKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.RevokeApiKey(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestVerifyApiKey(t *testing.T) {
+	user, _ := createRandomUser(t)
+	apiKey, key := createRandomApiKey(t, user)
+
+	revokedApiKey, revokedKey := createRandomApiKey(t, user)
+	revokedApiKey.RevokedAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	expiredApiKey, expiredKey := createRandomApiKey(t, user)
+	expiredApiKey.ExpiredAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	withdrawnUser := user
+	withdrawnUser.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		key           string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(payload *token.Payload, err CustomErr)
+	}{
+		{
+			name: "성공",
+			key:  key,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Eq(apiKey.Prefix)).
+					Times(1).
+					Return(apiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateApiKeyLastUsedAt(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Empty(t, err)
+				require.NotNil(t, payload)
+				require.Equal(t, user.ID, payload.UserID)
+				require.Equal(t, string(user.Role), payload.Role)
+				require.Equal(t, apiKey.ID, payload.ApiKeyID)
+				require.Equal(t, []string{dto.ApiKeyScopeProductsRead}, payload.Scopes)
+				require.Empty(t, payload.SessionID)
+			},
+		},
+		{
+			name: "마지막 사용 시간 갱신 실패",
+			key:  key,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(apiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateApiKeyLastUsedAt(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Empty(t, err)
+				require.NotNil(t, payload)
+			},
+		},
+		{
+			name: "잘못된 형식의 API 키",
+			key:  util.CreateRandomString(10),
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errInvalidApiKey)
+			},
+		},
+		{
+			name: "존재하지 않는 API 키",
+			key:  key,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.ApiKey{}, sql.ErrNoRows)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errInvalidApiKey)
+			},
+		},
+		{
+			name: "일치하지 않는 API 키",
+			key:  key[:len(key)-1] + "0",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(apiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errInvalidApiKey)
+			},
+		},
+		{
+			name: "폐기된 API 키",
+			key:  revokedKey,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(revokedApiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errRevokedApiKey)
+			},
+		},
+		{
+			name: "만료된 API 키",
+			key:  expiredKey,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(expiredApiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errExpiredApiKey)
+			},
+		},
+		{
+			name: "탈퇴한 회원",
+			key:  key,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(apiKey, nil)
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(withdrawnUser, nil)
+				mockRepository.EXPECT().
+					UpdateApiKeyLastUsedAt(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, errInvalidApiKey)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			key:  key,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetApiKeyByPrefix(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.ApiKey{}, sql.ErrConnDone)
+			},
+			checkResponse: func(payload *token.Payload, err CustomErr) {
+				require.Nil(t, payload)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			payload, err := service.VerifyApiKey(context.Background(), tc.key)
+			tc.checkResponse(payload, err)
+		})
+	}
+}
+
+func createRandomApiKey(t *testing.T, user repository.User) (repository.ApiKey, string) {
+	key, prefix, err := generateApiKey()
+	require.NoError(t, err)
+
+	apiKey := repository.ApiKey{
+		ID:        util.CreateRandomInt64(1, 10),
+		UserID:    user.ID,
+		Name:      util.CreateRandomString(10),
+		Prefix:    prefix,
+		HashedKey: hashApiKey(key),
+		Scopes:    dto.ApiKeyScopeProductsRead,
+		CreatedAt: time.Now(),
+	}
+
+	return apiKey, key
+}
@@ -8,16 +8,41 @@ import (
 
 	"github.com/gitaepark/pha/dto"
 	"github.com/gitaepark/pha/repository"
-	"github.com/gitaepark/pha/util/bcrypt"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/util/token"
 	"github.com/go-sql-driver/mysql"
+	"github.com/rs/zerolog/log"
 )
 
-type RegisterParams = dto.RegisterRequestBody
+// refresh 토큰 발급 기기 확인 정책
+const (
+	refreshTokenBindingStrict  = "strict"
+	refreshTokenBindingLenient = "lenient"
+	refreshTokenBindingOff     = "off"
+)
+
+type RegisterParams struct {
+	dto.RegisterRequestBody
+	UserAgent string
+	ClientIp  string
+}
 
 func (service *service) Register(ctx context.Context, params RegisterParams) (cErr CustomErr) {
+	event := authEvent{
+		Type:        repository.AuthEventTypeRegister,
+		PhoneNumber: params.PhoneNumber,
+		UserAgent:   params.UserAgent,
+		ClientIp:    params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 휴대폰 번호 인증 검증
+	cErr = service.verifyCode(ctx, params.PhoneNumber, repository.VerificationPurposeRegister, params.VerificationCode)
+	if cErr.Err != nil {
+		return
+	}
+
 	// 비밀번호 암호화
-	hashedPassword, err := bcrypt.HashPassword(params.Password)
+	hashedPassword, err := service.passwordHasher.HashPassword(params.Password)
 	if err != nil {
 		cErr = NewErrInternalServer(err)
 		return
@@ -28,8 +53,8 @@ func (service *service) Register(ctx context.Context, params RegisterParams) (cE
 		HashedPassword: hashedPassword,
 	}
 
-	// 회원 생성
-	err = service.repository.CreateUser(ctx, arg)
+	// 회원 생성 후 기본 매장 생성 (매장명은 휴대폰 번호로 설정)
+	userID, err := service.repository.RegisterTx(ctx, arg, params.PhoneNumber)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			switch mysqlErr.Number {
@@ -46,6 +71,7 @@ func (service *service) Register(ctx context.Context, params RegisterParams) (cE
 		cErr = NewErrInternalServer(err)
 		return
 	}
+	event.UserID = userID
 
 	return
 }
@@ -58,34 +84,108 @@ type LoginParams struct {
 
 // 로그인 로직
 func (service *service) Login(ctx context.Context, params LoginParams) (result dto.LoginResponseBody, cErr CustomErr) {
+	event := authEvent{
+		Type:        repository.AuthEventTypeLogin,
+		PhoneNumber: params.PhoneNumber,
+		UserAgent:   params.UserAgent,
+		ClientIp:    params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 로그인 잠금 검증
+	cErr = service.checkLoginLock(ctx, params)
+	if cErr.Err != nil {
+		return
+	}
+
 	// 회원 검색
 	user, err := service.repository.GetUser(ctx, params.PhoneNumber)
 	if err != nil {
 		// 해당 휴대폰 번호의 회원이 없는 경우
 		if err == sql.ErrNoRows {
-			cErr = errNotFoundUser
+			cErr = service.failLogin(ctx, params, errNotFoundUser)
 			return
 		}
 		cErr = NewErrInternalServer(err)
 		return
 	}
+	event.UserID = user.ID
+	// 탈퇴 유예 기간이 지나 삭제 대기 중인 경우
+	if service.isPurgeableUser(user) {
+		cErr = service.failLogin(ctx, params, errNotFoundUser)
+		return
+	}
 
 	// 비밀번호 검증 로직
-	err = bcrypt.CheckPassword(params.Password, user.HashedPassword)
+	err = service.passwordHasher.CheckPassword(params.Password, user.HashedPassword)
 	if err != nil {
-		cErr = errWrongPassword
+		cErr = service.failLogin(ctx, params, errWrongPassword)
 		return
 	}
 
-	// access 토큰 생성
-	accessToken, _, err := jwt.CreateToken(user.ID, service.config.JWTSecret, service.config.AccessTokenDuration)
+	// 이전 알고리즘, 파라미터로 암호화된 비밀번호인 경우 재암호화
+	// 재암호화에 실패해도 로그인은 진행
+	if service.passwordHasher.NeedsRehash(user.HashedPassword) {
+		service.rehashPassword(ctx, user.ID, params.Password)
+	}
+
+	// 2단계 인증을 사용하는 경우 인증 대기 토큰 발급
+	if user.IsTotpEnabled {
+		twoFactorToken, _, err := service.tokenMaker.CreatePurposeToken(user.ID, token.PurposeTwoFactor, service.config.TwoFactorTokenDuration)
+		if err != nil {
+			cErr = NewErrInternalServer(err)
+			return
+		}
+
+		event.Reason = "two factor required"
+		result = dto.LoginResponseBody{TwoFactorRequired: true, TwoFactorToken: twoFactorToken}
+		return
+	}
+
+	// 휴대폰 번호 실패 기록 초기화
+	// 2단계 인증 회원은 2단계 인증 성공 후 초기화 (비밀번호 재로그인으로 2단계 인증 잠금 우회 방지)
+	// ip 실패 기록은 다른 계정 로그인으로 초기화되지 않도록 유지
+	err = service.phoneLimiter.Reset(ctx, phoneLockoutKey(params.PhoneNumber))
 	if err != nil {
 		cErr = NewErrInternalServer(err)
 		return
 	}
 
+	result, cErr = service.completeLogin(ctx, user, params.UserAgent, params.ClientIp)
+	return
+}
+
+// 로그인 완료 처리 (탈퇴 취소, 세션 생성)
+func (service *service) completeLogin(ctx context.Context, user repository.User, userAgent, clientIp string) (result dto.LoginResponseBody, cErr CustomErr) {
+	// 동시 세션 수 제한 확인
+	evictedSessionList, cErr := service.checkSessionLimit(ctx, user.ID)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 탈퇴 유예 기간 중 로그인한 경우 탈퇴 취소
+	if user.DeletedAt.Valid {
+		err := service.repository.RestoreUser(ctx, user.ID)
+		if err != nil {
+			cErr = NewErrInternalServer(err)
+			return
+		}
+
+		log.Info().Int64("user_id", user.ID).Msg("user withdrawal cancelled")
+	}
+
+	// 새 기기 로그인 감지 (현재 기기 기록 전 이전 로그인 기기 기록과 비교)
+	newDeviceReason := service.detectNewDevice(ctx, user.ID, userAgent, clientIp)
+
 	// refresh 토큰 생성
-	refreshToken, refreshPayload, err := jwt.CreateToken(user.ID, service.config.JWTSecret, service.config.RefreshTokenDuration)
+	refreshToken, refreshPayload, err := service.tokenMaker.CreateRefreshToken(user.ID, string(user.Role), service.config.RefreshTokenDuration)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// access 토큰 생성
+	accessToken, _, err := service.tokenMaker.CreateToken(user.ID, string(user.Role), refreshPayload.ID, service.config.AccessTokenDuration)
 	if err != nil {
 		cErr = NewErrInternalServer(err)
 		return
@@ -94,9 +194,10 @@ func (service *service) Login(ctx context.Context, params LoginParams) (result d
 	arg := repository.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		UserID:       user.ID,
+		FamilyID:     refreshPayload.ID,
 		RefreshToken: refreshToken,
-		UserAgent:    params.UserAgent,
-		ClientIp:     params.ClientIp,
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
 		IsBlocked:    false,
 		ExpiredAt:    refreshPayload.ExpiredAt,
 	}
@@ -108,10 +209,104 @@ func (service *service) Login(ctx context.Context, params LoginParams) (result d
 		return
 	}
 
+	// 새 세션 저장 후 오래된 세션 정리
+	cErr = service.evictSessions(ctx, evictedSessionList)
+	if cErr.Err != nil {
+		return
+	}
+
+	service.rememberDevice(ctx, user.ID, userAgent, clientIp)
+
+	if newDeviceReason != "" {
+		service.notifyNewDevice(ctx, user, userAgent, clientIp, newDeviceReason)
+	}
+
 	result = dto.LoginResponseBody{AccessToken: accessToken, RefreshToken: refreshToken}
+	for _, session := range evictedSessionList {
+		result.EvictedSessionList = append(result.EvictedSessionList, dto.NewGetSessionResponse(session, ""))
+	}
 	return
 }
 
+// 비밀번호 재암호화
+func (service *service) rehashPassword(ctx context.Context, userID int64, password string) {
+	hashedPassword, err := service.passwordHasher.HashPassword(password)
+	if err != nil {
+		log.Error().Int64("user_id", userID).Err(err).Msg("failed to rehash password")
+		return
+	}
+
+	arg := repository.UpdateUserPasswordParams{
+		HashedPassword: hashedPassword,
+		ID:             userID,
+	}
+
+	err = service.repository.UpdateUserPassword(ctx, arg)
+	if err != nil {
+		log.Error().Int64("user_id", userID).Err(err).Msg("failed to rehash password")
+		return
+	}
+
+	log.Info().Int64("user_id", userID).Msg("password rehashed")
+}
+
+func phoneLockoutKey(phoneNumber string) string {
+	return "phone:" + phoneNumber
+}
+
+func ipLockoutKey(clientIp string) string {
+	return "ip:" + clientIp
+}
+
+// 휴대폰 번호, ip 잠금 검증
+func (service *service) checkLoginLock(ctx context.Context, params LoginParams) CustomErr {
+	retryAfter, err := service.phoneLimiter.Check(ctx, phoneLockoutKey(params.PhoneNumber))
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+	if retryAfter > 0 {
+		log.Info().Str("phone_number", params.PhoneNumber).Str("client_ip", params.ClientIp).Dur("retry_after", retryAfter).Msg("login rejected: account is locked")
+		return errLockedAccount(retryAfter)
+	}
+
+	retryAfter, err = service.ipLimiter.Check(ctx, ipLockoutKey(params.ClientIp))
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+	if retryAfter > 0 {
+		log.Info().Str("phone_number", params.PhoneNumber).Str("client_ip", params.ClientIp).Dur("retry_after", retryAfter).Msg("login rejected: too many attempts from ip")
+		return errTooManyLoginAttempts(retryAfter)
+	}
+
+	return CustomErr{}
+}
+
+// 로그인 실패 기록
+// 이번 실패로 잠긴 경우 잠금 에러, 아닌 경우 원래 에러 반환
+func (service *service) failLogin(ctx context.Context, params LoginParams, cErr CustomErr) CustomErr {
+	phoneRetryAfter, err := service.phoneLimiter.Fail(ctx, phoneLockoutKey(params.PhoneNumber))
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	ipRetryAfter, err := service.ipLimiter.Fail(ctx, ipLockoutKey(params.ClientIp))
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	if phoneRetryAfter > 0 {
+		log.Warn().Str("phone_number", params.PhoneNumber).Str("client_ip", params.ClientIp).Dur("retry_after", phoneRetryAfter).Msg("account locked after failed logins")
+		return errLockedAccount(phoneRetryAfter)
+	}
+
+	if ipRetryAfter > 0 {
+		log.Warn().Str("phone_number", params.PhoneNumber).Str("client_ip", params.ClientIp).Dur("retry_after", ipRetryAfter).Msg("ip locked after failed logins")
+		return errTooManyLoginAttempts(ipRetryAfter)
+	}
+
+	return cErr
+}
+
 type RenewAccessTokenParams struct {
 	dto.RenewAccessTokenRequestBody
 	UserAgent string
@@ -120,12 +315,200 @@ type RenewAccessTokenParams struct {
 
 // access 토큰 재발급 로직
 func (service *service) RenewAccessToken(ctx context.Context, params RenewAccessTokenParams) (result dto.RenewAccessTokenResponse, cErr CustomErr) {
+	event := authEvent{
+		Type:      repository.AuthEventTypeRenewAccessToken,
+		UserAgent: params.UserAgent,
+		ClientIp:  params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
 	// 토큰 검증
-	refreshPayload, err := jwt.VerifyToken(params.RefreshToken, service.config.JWTSecret)
+	refreshPayload, err := service.tokenMaker.VerifyToken(params.RefreshToken)
 	if err != nil {
 		cErr = NewErrBadRequest(err)
 		return
 	}
+	event.UserID = refreshPayload.UserID
+	// refresh 토큰이 아닌 경우
+	if refreshPayload.Kind != token.KindRefresh {
+		cErr = NewErrBadRequest(token.ErrInvalidToken)
+		return
+	}
+
+	// 세션 검색 및 검증
+	session, cErr := service.getSession(ctx, refreshPayload.ID, refreshPayload.UserID)
+	if cErr.Err != nil {
+		return
+	}
+	// refresh 토큰이 일치하지 않는 경우
+	if session.RefreshToken != params.RefreshToken {
+		cErr = errMismatchedSessionToken
+		return
+	}
+	// 이미 재발급에 사용된 refresh 토큰인 경우
+	if session.IsRotated {
+		cErr = service.blockSessionFamily(ctx, session.UserID, session.FamilyID)
+		return
+	}
+	// 세션이 만료된 경우
+	if time.Now().After(session.ExpiredAt) {
+		cErr = errExpiredSession
+		return
+	}
+	// refresh 토큰을 발급받은 기기가 아닌 경우
+	cErr = service.checkSessionClient(ctx, session, params.UserAgent, params.ClientIp)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 새 refresh 토큰 생성 (기존 세션의 만료 시각 유지)
+	refreshToken, newRefreshPayload, err := service.tokenMaker.CreateRefreshToken(refreshPayload.UserID, refreshPayload.Role, time.Until(session.ExpiredAt))
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// access 토큰 생성
+	accessToken, _, err := service.tokenMaker.CreateToken(refreshPayload.UserID, refreshPayload.Role, newRefreshPayload.ID, service.config.AccessTokenDuration)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.CreateSessionParams{
+		ID:           newRefreshPayload.ID,
+		UserID:       session.UserID,
+		FamilyID:     session.FamilyID,
+		RefreshToken: refreshToken,
+		UserAgent:    params.UserAgent,
+		ClientIp:     params.ClientIp,
+		IsBlocked:    false,
+		ExpiredAt:    newRefreshPayload.ExpiredAt,
+	}
+
+	// 기존 세션 교체 및 새 세션 저장 (트랜잭션)
+	rows, err := service.repository.RotateSessionTx(ctx, session.ID, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	// 동시에 같은 refresh 토큰으로 재발급을 요청한 경우
+	if rows == 0 {
+		cErr = service.blockSessionFamily(ctx, session.UserID, session.FamilyID)
+		return
+	}
+
+	result = dto.RenewAccessTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return
+}
+
+// 세션 검색 및 검증 (세션 존재, 차단 여부, 세션 회원)
+func (service *service) getSession(ctx context.Context, sessionID string, userID int64) (repository.Session, CustomErr) {
+	session, err := service.repository.GetSession(ctx, sessionID)
+	if err != nil {
+		// 해당 id의 세션이 없는 경우
+		if err == sql.ErrNoRows {
+			return session, errNotFoundSession
+		}
+
+		return session, NewErrInternalServer(err)
+	}
+
+	// 세션이 막힌 경우
+	if session.IsBlocked {
+		return session, errBlockedSession
+	}
+	// 세션 회원이 아닌 경우
+	if session.UserID != userID {
+		return session, errIncorrectSessionUser
+	}
+
+	return session, CustomErr{}
+}
+
+// refresh 토큰 재사용 감지 시 같은 계열의 세션 전체 차단 및 access 토큰 폐기
+func (service *service) blockSessionFamily(ctx context.Context, userID int64, familyID string) CustomErr {
+	err := service.repository.BlockSessionFamily(ctx, familyID)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	cErr := service.revokeSessionFamilyTokens(ctx, userID, familyID)
+	if cErr.Err != nil {
+		return cErr
+	}
+
+	log.Warn().Str("family_id", familyID).Msg("refresh token reuse detected")
+
+	return errReusedRefreshToken
+}
+
+// refresh 토큰 발급 기기 확인
+// strict: 세션의 user agent와 다르면 세션(계열) 차단, lenient: 경고 로그만 기록, off: 확인하지 않음
+func (service *service) checkSessionClient(ctx context.Context, session repository.Session, userAgent, clientIp string) CustomErr {
+	policy := service.config.RefreshTokenBinding
+	if policy == refreshTokenBindingOff || session.UserAgent == userAgent {
+		return CustomErr{}
+	}
+
+	logger := log.Warn().
+		Str("session_id", session.ID).
+		Str("session_user_agent", session.UserAgent).
+		Str("user_agent", userAgent).
+		Str("client_ip", clientIp)
+
+	if policy == refreshTokenBindingLenient {
+		logger.Msg("refresh token used from different client")
+		return CustomErr{}
+	}
+
+	// 알 수 없는 정책은 strict로 처리
+	err := service.repository.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	cErr := service.revokeSessionFamilyTokens(ctx, session.UserID, session.FamilyID)
+	if cErr.Err != nil {
+		return cErr
+	}
+
+	logger.Msg("refresh token used from different client, session blocked")
+
+	return errMismatchedSessionClient
+}
+
+type LogoutParams struct {
+	dto.LogoutRequestBody
+	UserAgent string
+	ClientIp  string
+}
+
+// 로그아웃 로직
+func (service *service) Logout(ctx context.Context, params LogoutParams) (cErr CustomErr) {
+	event := authEvent{
+		Type:      repository.AuthEventTypeLogout,
+		UserAgent: params.UserAgent,
+		ClientIp:  params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 토큰 검증
+	refreshPayload, err := service.tokenMaker.VerifyToken(params.RefreshToken)
+	if err != nil {
+		cErr = NewErrBadRequest(err)
+		return
+	}
+	event.UserID = refreshPayload.UserID
+	// refresh 토큰이 아닌 경우
+	if refreshPayload.Kind != token.KindRefresh {
+		cErr = NewErrBadRequest(token.ErrInvalidToken)
+		return
+	}
 
 	// 세션 검색
 	session, err := service.repository.GetSession(ctx, refreshPayload.ID)
@@ -141,11 +524,6 @@ func (service *service) RenewAccessToken(ctx context.Context, params RenewAccess
 	}
 
 	// 세션 검증
-	// 세션이 막힌 경우
-	if session.IsBlocked {
-		cErr = errBlockedSession
-		return
-	}
 	// 세션 회원이 아닌 경우
 	if session.UserID != refreshPayload.UserID {
 		cErr = errIncorrectSessionUser
@@ -156,22 +534,172 @@ func (service *service) RenewAccessToken(ctx context.Context, params RenewAccess
 		cErr = errMismatchedSessionToken
 		return
 	}
-	// 세션이 만료된 경우
-	if time.Now().After(session.ExpiredAt) {
-		cErr = errExpiredSession
+	// 이미 막힌 세션인 경우
+	if session.IsBlocked {
+		return
+	}
+	// 이미 재발급에 사용된 refresh 토큰인 경우 (재발급과 같이 재사용으로 보고 계열 전체 차단)
+	if session.IsRotated {
+		cErr = service.blockSessionFamily(ctx, session.UserID, session.FamilyID)
 		return
 	}
 
-	// access 토큰 생성
-	accessToken, _, err := jwt.CreateToken(refreshPayload.UserID, service.config.JWTSecret, service.config.AccessTokenDuration)
+	// 세션 차단
+	err = service.repository.BlockSession(ctx, session.ID)
 	if err != nil {
 		cErr = NewErrInternalServer(err)
 		return
 	}
 
-	result = dto.RenewAccessTokenResponse{
-		AccessToken:  accessToken,
-		RefreshToken: params.RefreshToken,
+	// 발급된 access 토큰 폐기 (재발급 전 토큰 포함)
+	cErr = service.revokeSessionFamilyTokens(ctx, session.UserID, session.FamilyID)
+	return
+}
+
+type ChangePasswordParams struct {
+	UserID    int64
+	SessionID string
+	dto.ChangePasswordRequestBody
+	UserAgent string
+	ClientIp  string
+}
+
+// 비밀번호 변경 로직
+func (service *service) ChangePassword(ctx context.Context, params ChangePasswordParams) (cErr CustomErr) {
+	event := authEvent{
+		Type:      repository.AuthEventTypeChangePassword,
+		UserID:    params.UserID,
+		UserAgent: params.UserAgent,
+		ClientIp:  params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	event.PhoneNumber = user.PhoneNumber
+
+	// 현재 비밀번호 검증
+	err = service.passwordHasher.CheckPassword(params.CurrentPassword, user.HashedPassword)
+	if err != nil {
+		cErr = errWrongPassword
+		return
+	}
+
+	// 새 비밀번호 검증
+	if params.NewPassword == params.CurrentPassword {
+		cErr = errSamePassword
+		return
+	}
+	err = service.passwordPolicy.Validate(params.NewPassword, user.PhoneNumber)
+	if err != nil {
+		cErr = NewErrBadRequest(err)
+		return
+	}
+
+	// 비밀번호 암호화
+	hashedPassword, err := service.passwordHasher.HashPassword(params.NewPassword)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.UpdateUserPasswordParams{
+		HashedPassword: hashedPassword,
+		ID:             user.ID,
+	}
+
+	// 비밀번호 변경
+	err = service.repository.UpdateUserPassword(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 현재 세션 외 모두 차단
+	if params.SessionID == "" {
+		cErr = service.blockUserSessions(ctx, user.ID)
+		return
+	}
+
+	cErr = service.blockOtherSessions(ctx, user.ID, params.SessionID)
+	return
+}
+
+type ResetPasswordParams struct {
+	dto.ResetPasswordRequestBody
+	UserAgent string
+	ClientIp  string
+}
+
+// 비밀번호 재설정 로직
+func (service *service) ResetPassword(ctx context.Context, params ResetPasswordParams) (cErr CustomErr) {
+	event := authEvent{
+		Type:        repository.AuthEventTypeResetPassword,
+		PhoneNumber: params.PhoneNumber,
+		UserAgent:   params.UserAgent,
+		ClientIp:    params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 휴대폰 번호 인증 검증
+	// 회원 존재 여부를 노출하지 않도록 회원 검색보다 먼저 검증
+	cErr = service.verifyCode(ctx, params.PhoneNumber, repository.VerificationPurposePasswordReset, params.VerificationCode)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 회원 검색
+	user, err := service.repository.GetUser(ctx, params.PhoneNumber)
+	if err != nil {
+		// 해당 휴대폰 번호의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	event.UserID = user.ID
+
+	// 비밀번호 암호화
+	hashedPassword, err := service.passwordHasher.HashPassword(params.NewPassword)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.UpdateUserPasswordParams{
+		HashedPassword: hashedPassword,
+		ID:             user.ID,
+	}
+
+	// 비밀번호 변경
+	err = service.repository.UpdateUserPassword(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 모든 세션 차단
+	cErr = service.blockUserSessions(ctx, user.ID)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 휴대폰 번호 로그인 잠금 해제
+	err = service.phoneLimiter.Reset(ctx, phoneLockoutKey(params.PhoneNumber))
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
 	}
 
 	return
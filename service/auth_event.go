@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/rs/zerolog/log"
+)
+
+// 인증 이벤트 사유 최대 길이 (auth_event.reason 컬럼 길이)
+const authEventReasonMaxLength = 255
+
+// user agent 최대 길이 (auth_event, known_device의 user_agent 컬럼 길이)
+const userAgentMaxLength = 255
+
+// 인증 이벤트 기록 정보
+type authEvent struct {
+	Type        repository.AuthEventType
+	UserID      int64
+	PhoneNumber string
+	Reason      string
+	UserAgent   string
+	ClientIp    string
+}
+
+// 인증 이벤트 기록
+// 기록에 실패해도 인증 요청 결과에는 영향을 주지 않음
+func (service *service) recordAuthEvent(ctx context.Context, event authEvent, cErr CustomErr) {
+	arg := repository.CreateAuthEventParams{
+		UserID:      sql.NullInt64{Int64: event.UserID, Valid: event.UserID != 0},
+		PhoneNumber: sql.NullString{String: maskPhoneNumber(event.PhoneNumber), Valid: event.PhoneNumber != ""},
+		Type:        event.Type,
+		Outcome:     repository.AuthEventOutcomeSuccess,
+		Reason:      sql.NullString{String: event.Reason, Valid: event.Reason != ""},
+		ClientIp:    event.ClientIp,
+		UserAgent:   truncateString(event.UserAgent, userAgentMaxLength),
+	}
+	// 실패한 경우 반환된 에러를 사유로 기록
+	if cErr.Err != nil {
+		arg.Outcome = repository.AuthEventOutcomeFailure
+		arg.Reason = sql.NullString{String: cErr.Err.Error(), Valid: true}
+	}
+	arg.Reason.String = truncateString(arg.Reason.String, authEventReasonMaxLength)
+
+	err := service.repository.CreateAuthEvent(ctx, arg)
+	if err != nil {
+		log.Error().Str("type", string(event.Type)).Int64("user_id", event.UserID).Err(err).Msg("failed to record auth event")
+	}
+}
+
+// 컬럼 길이를 넘는 문자열 자르기
+// varchar 길이는 문자 수 기준이므로 rune 단위로 자름
+func truncateString(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+
+	return string(runes[:maxLength])
+}
+
+// 휴대폰 번호 가운데 4자리 마스킹 (01012345678 -> 010****5678)
+func maskPhoneNumber(phoneNumber string) string {
+	if len(phoneNumber) < 8 {
+		return phoneNumber
+	}
+
+	return phoneNumber[:3] + "****" + phoneNumber[7:]
+}
+
+type GetAuthEventListParams struct {
+	UserID int64
+	dto.GetAuthEventListRequestQuery
+}
+
+// 인증 이벤트 목록 조회 로직 (본인 이력만 조회)
+func (service *service) GetAuthEventList(ctx context.Context, params GetAuthEventListParams) (result dto.GetAuthEventListResponse, cErr CustomErr) {
+	arg := repository.GetAuthEventListParams{
+		UserID: sql.NullInt64{Int64: params.UserID, Valid: true},
+		Offset: 10 * (params.Page - 1),
+	}
+
+	authEventList, err := service.repository.GetAuthEventList(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetAuthEventListResponse(authEventList)
+	return
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAuthEvent(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	phoneNumber := util.CreateRandomPhoneNumber()
+	maskedPhoneNumber := phoneNumber[:3] + "****" + phoneNumber[7:]
+	longUserAgent := util.CreateRandomString(userAgentMaxLength + 10)
+
+	testCases := []struct {
+		name       string
+		event      authEvent
+		cErr       CustomErr
+		buildStubs func(mockRepository *mockrepository.MockRepository)
+	}{
+		{
+			name: "성공",
+			event: authEvent{
+				Type:        repository.AuthEventTypeLogin,
+				UserID:      userID,
+				PhoneNumber: phoneNumber,
+				UserAgent:   userAgent,
+				ClientIp:    clientIp,
+			},
+			cErr: CustomErr{},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.CreateAuthEventParams{
+					UserID:      sql.NullInt64{Int64: userID, Valid: true},
+					PhoneNumber: sql.NullString{String: maskedPhoneNumber, Valid: true},
+					Type:        repository.AuthEventTypeLogin,
+					Outcome:     repository.AuthEventOutcomeSuccess,
+					ClientIp:    clientIp,
+					UserAgent:   userAgent,
+				}
+
+				mockRepository.EXPECT().
+					CreateAuthEvent(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "실패한 경우 에러를 사유로 기록",
+			event: authEvent{
+				Type:        repository.AuthEventTypeLogin,
+				PhoneNumber: phoneNumber,
+				UserAgent:   userAgent,
+				ClientIp:    clientIp,
+			},
+			cErr: errNotFoundUser,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.CreateAuthEventParams{
+					PhoneNumber: sql.NullString{String: maskedPhoneNumber, Valid: true},
+					Type:        repository.AuthEventTypeLogin,
+					Outcome:     repository.AuthEventOutcomeFailure,
+					Reason:      sql.NullString{String: errNotFoundUser.Err.Error(), Valid: true},
+					ClientIp:    clientIp,
+					UserAgent:   userAgent,
+				}
+
+				mockRepository.EXPECT().
+					CreateAuthEvent(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "컬럼 길이를 넘는 user agent",
+			event: authEvent{
+				Type:      repository.AuthEventTypeLogin,
+				UserID:    userID,
+				UserAgent: longUserAgent,
+				ClientIp:  clientIp,
+			},
+			cErr: CustomErr{},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.CreateAuthEventParams{
+					UserID:    sql.NullInt64{Int64: userID, Valid: true},
+					Type:      repository.AuthEventTypeLogin,
+					Outcome:   repository.AuthEventOutcomeSuccess,
+					ClientIp:  clientIp,
+					UserAgent: string([]rune(longUserAgent)[:userAgentMaxLength]),
+				}
+
+				mockRepository.EXPECT().
+					CreateAuthEvent(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "기록 실패",
+			event: authEvent{
+				Type:      repository.AuthEventTypeLogout,
+				UserID:    userID,
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			cErr: CustomErr{},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateAuthEvent(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository).(*service)
+
+			tc.buildStubs(repository)
+
+			service.recordAuthEvent(context.Background(), tc.event, tc.cErr)
+		})
+	}
+}
+
+func TestTruncateString(t *testing.T) {
+	value := util.CreateRandomString(10)
+
+	require.Equal(t, value, truncateString(value, 10))
+	require.Equal(t, string([]rune(value)[:5]), truncateString(value, 5))
+	require.Equal(t, "", truncateString("", 5))
+}
+
+func TestMaskPhoneNumber(t *testing.T) {
+	require.Equal(t, "010****5678", maskPhoneNumber("01012345678"))
+	require.Equal(t, "", maskPhoneNumber(""))
+}
+
+func TestGetAuthEventList(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	page := util.CreateRandomInt32(1, 5)
+
+	var authEventList []repository.AuthEvent
+	for i := 0; i < 3; i++ {
+		authEventList = append(authEventList, repository.AuthEvent{
+			ID:        util.CreateRandomInt64(1, 1000),
+			UserID:    sql.NullInt64{Int64: userID, Valid: true},
+			Type:      repository.AuthEventTypeLogin,
+			Outcome:   repository.AuthEventOutcomeFailure,
+			Reason:    sql.NullString{String: errWrongPassword.Err.Error(), Valid: true},
+			ClientIp:  clientIp,
+			UserAgent: userAgent,
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		params        GetAuthEventListParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetAuthEventListResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: GetAuthEventListParams{
+				UserID:                       userID,
+				GetAuthEventListRequestQuery: dto.GetAuthEventListRequestQuery{Page: page},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.GetAuthEventListParams{
+					UserID: sql.NullInt64{Int64: userID, Valid: true},
+					Offset: 10 * (page - 1),
+				}
+
+				mockRepository.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(authEventList, nil)
+			},
+			checkResponse: func(result dto.GetAuthEventListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, len(authEventList))
+				for i, authEvent := range result.List {
+					require.Equal(t, authEventList[i].ID, authEvent.ID)
+					require.Equal(t, string(authEventList[i].Outcome), authEvent.Outcome)
+					require.Equal(t, authEventList[i].Reason.String, *authEvent.Reason)
+					require.Nil(t, authEvent.PhoneNumber)
+				}
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: GetAuthEventListParams{
+				UserID:                       userID,
+				GetAuthEventListRequestQuery: dto.GetAuthEventListRequestQuery{Page: page},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetAuthEventList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.AuthEvent{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetAuthEventListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetAuthEventList(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"math/rand"
 	"net"
+	"net/http"
 	"testing"
 	"time"
 
@@ -14,8 +15,13 @@ import (
 	"github.com/gitaepark/pha/repository"
 	mockrepository "github.com/gitaepark/pha/repository/mock"
 	"github.com/gitaepark/pha/util"
-	"github.com/gitaepark/pha/util/bcrypt"
-	"github.com/gitaepark/pha/util/jwt"
+	"github.com/gitaepark/pha/util/hasher"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/gitaepark/pha/util/validator"
 	"github.com/go-sql-driver/mysql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -36,6 +42,7 @@ func init() {
 
 func TestRegister(t *testing.T) {
 	user, password := createRandomUser(t)
+	verification, code := createRandomVerification(t, user.PhoneNumber, repository.VerificationPurposeRegister)
 
 	testCases := []struct {
 		name          string
@@ -46,14 +53,25 @@ func TestRegister(t *testing.T) {
 		{
 			name: "성공",
 			params: RegisterParams{
-				PhoneNumber: user.PhoneNumber,
-				Password:    password,
+				RegisterRequestBody: dto.RegisterRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					Password:         password,
+					VerificationCode: code,
+				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
 				mockRepository.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					GetLatestVerification(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil)
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					RegisterTx(gomock.Any(), gomock.Any(), gomock.Eq(user.PhoneNumber)).
+					Times(1).
+					Return(user.ID, nil)
 			},
 			checkResponse: func(err CustomErr) {
 				require.Empty(t, err)
@@ -62,14 +80,25 @@ func TestRegister(t *testing.T) {
 		{
 			name: "중복된 휴대폰번호",
 			params: RegisterParams{
-				PhoneNumber: user.PhoneNumber,
-				Password:    password,
+				RegisterRequestBody: dto.RegisterRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					Password:         password,
+					VerificationCode: code,
+				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
 				mockRepository.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					RegisterTx(gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(&mysql.MySQLError{
+					Return(int64(0), &mysql.MySQLError{
 						Number:  repository.DB_DUPLICATE_ERROR,
 						Message: "phone_number",
 					})
@@ -78,17 +107,54 @@ func TestRegister(t *testing.T) {
 				require.Equal(t, err, errDuplicatePhoneNumber)
 			},
 		},
+		{
+			name: "인증번호 불일치",
+			params: RegisterParams{
+				RegisterRequestBody: dto.RegisterRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					Password:         password,
+					VerificationCode: createWrongVerificationCode(code),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					IncreaseVerificationAttempt(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					RegisterTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongVerificationCode)
+			},
+		},
 		{
 			name: "Internal Server Error",
 			params: RegisterParams{
-				PhoneNumber: user.PhoneNumber,
-				Password:    password,
+				RegisterRequestBody: dto.RegisterRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					Password:         password,
+					VerificationCode: code,
+				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
 				mockRepository.EXPECT().
-					CreateUser(gomock.Any(), gomock.Any()).
+					GetLatestVerification(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					RegisterTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
 			},
 			checkResponse: func(err CustomErr) {
 				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
@@ -107,6 +173,9 @@ func TestRegister(t *testing.T) {
 			service := newTestService(t, repository)
 
 			tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
 
 			err := service.Register(context.Background(), tc.params)
 			tc.checkResponse(err)
@@ -117,6 +186,18 @@ func TestRegister(t *testing.T) {
 func TestLogin(t *testing.T) {
 	user, password := createRandomUser(t)
 
+	withdrawnUser := user
+	withdrawnUser.DeletedAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	purgeableUser := user
+	purgeableUser.DeletedAt = sql.NullTime{Time: time.Now().Add(-testConfig.UserWithdrawalGracePeriod), Valid: true}
+
+	// 이전 알고리즘으로 암호화된 비밀번호를 가진 회원
+	legacyUser := user
+	legacyUser.HashedPassword, _ = hasher.NewBcryptHasher(0).HashPassword(password)
+
+	twoFactorUser, _ := createRandomTwoFactorUser(t, user)
+
 	testCases := []struct {
 		name          string
 		params        LoginParams
@@ -138,14 +219,166 @@ func TestLogin(t *testing.T) {
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
 				mockRepository.EXPECT().
 					CreateSession(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(nil)
 			},
 			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
-				payload, _ := jwt.VerifyToken(result.AccessToken, testConfig.JWTSecret)
+				payload, _ := testTokenMaker.VerifyToken(result.AccessToken)
+				require.Equal(t, user.ID, payload.UserID)
+				require.Equal(t, string(user.Role), payload.Role)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "2단계 인증을 사용하는 경우",
+			params: LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, err)
+				require.True(t, result.TwoFactorRequired)
+				require.Empty(t, result.AccessToken)
+				require.Empty(t, result.RefreshToken)
+				payload, _ := testTokenMaker.VerifyToken(result.TwoFactorToken)
 				require.Equal(t, user.ID, payload.UserID)
+				require.Equal(t, token.PurposeTwoFactor, payload.Purpose)
+			},
+		},
+		{
+			name: "탈퇴 유예 기간 중 로그인한 경우 탈퇴 취소",
+			params: LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(withdrawnUser, nil)
+				mockRepository.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "탈퇴 유예 기간이 지난 경우",
+			params: LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(purgeableUser, nil)
+				mockRepository.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "이전 알고리즘으로 암호화된 비밀번호 재암호화",
+			params: LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(legacyUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.UpdateUserPasswordParams) error {
+						require.Equal(t, arg.ID, user.ID)
+						require.False(t, testPasswordHasher.NeedsRehash(arg.HashedPassword))
+						require.NoError(t, testPasswordHasher.CheckPassword(password, arg.HashedPassword))
+						return nil
+					})
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "재암호화에 실패해도 로그인 성공",
+			params: LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(legacyUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
 				require.Empty(t, err)
 			},
 		},
@@ -234,6 +467,16 @@ func TestLogin(t *testing.T) {
 			service := newTestService(t, repository)
 
 			tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+			repository.EXPECT().
+				GetKnownDeviceList(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(nil, nil)
+			repository.EXPECT().
+				CreateKnownDevice(gomock.Any(), gomock.Any()).
+				AnyTimes()
 
 			result, err := service.Login(context.Background(), tc.params)
 			tc.checkResponse(result, err)
@@ -241,73 +484,414 @@ func TestLogin(t *testing.T) {
 	}
 }
 
-func TestRenewAccessToken(t *testing.T) {
-	user, _ := createRandomUser(t)
+func TestLoginLockout(t *testing.T) {
+	config := testConfig
+	config.LoginMaxAttempts = 3
+	config.LoginMaxAttemptsPerIp = 5
+	config.LoginLockDuration = time.Minute
+	config.LoginMaxLockDuration = time.Hour
+
+	newLoginParams := func(phoneNumber string, password string) LoginParams {
+		return LoginParams{
+			LoginRequestBody: dto.LoginRequestBody{
+				PhoneNumber: phoneNumber,
+				Password:    password,
+			},
+			UserAgent: userAgent,
+			ClientIp:  clientIp,
+		}
+	}
+
+	t.Run("휴대폰 번호 잠금", func(t *testing.T) {
+		user, password := createRandomUser(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepository := mockrepository.NewMockRepository(ctrl)
+		service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+		mockRepository.EXPECT().
+			CreateAuthEvent(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		mockRepository.EXPECT().
+			GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+			Times(3).
+			Return(user, nil)
+		mockRepository.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			Times(0)
+
+		for i := 0; i < 2; i++ {
+			_, err := service.Login(context.Background(), newLoginParams(user.PhoneNumber, util.CreateRandomString(10)))
+			require.Equal(t, err, errWrongPassword)
+		}
+
+		_, err := service.Login(context.Background(), newLoginParams(user.PhoneNumber, util.CreateRandomString(10)))
+		require.Equal(t, err.Code, http.StatusLocked)
+		require.InDelta(t, time.Minute, err.RetryAfter, float64(time.Second))
+
+		// 잠금 중에는 올바른 비밀번호도 거부
+		_, err = service.Login(context.Background(), newLoginParams(user.PhoneNumber, password))
+		require.Equal(t, err.Code, http.StatusLocked)
+		require.Greater(t, err.RetryAfter, time.Duration(0))
+	})
+
+	t.Run("ip 잠금", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepository := mockrepository.NewMockRepository(ctrl)
+		service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+		mockRepository.EXPECT().
+			CreateAuthEvent(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		mockRepository.EXPECT().
+			GetUser(gomock.Any(), gomock.Any()).
+			Times(5).
+			Return(repository.User{}, sql.ErrNoRows)
+
+		for i := 0; i < 4; i++ {
+			_, err := service.Login(context.Background(), newLoginParams(util.CreateRandomPhoneNumber(), util.CreateRandomString(10)))
+			require.Equal(t, err, errNotFoundUser)
+		}
+
+		_, err := service.Login(context.Background(), newLoginParams(util.CreateRandomPhoneNumber(), util.CreateRandomString(10)))
+		require.Equal(t, err.Code, http.StatusTooManyRequests)
+		require.InDelta(t, time.Minute, err.RetryAfter, float64(time.Second))
+
+		_, err = service.Login(context.Background(), newLoginParams(util.CreateRandomPhoneNumber(), util.CreateRandomString(10)))
+		require.Equal(t, err.Code, http.StatusTooManyRequests)
+	})
+
+	t.Run("로그인 성공 시 휴대폰 번호 실패 기록 초기화", func(t *testing.T) {
+		user, password := createRandomUser(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepository := mockrepository.NewMockRepository(ctrl)
+		service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+		mockRepository.EXPECT().
+			CreateAuthEvent(gomock.Any(), gomock.Any()).
+			AnyTimes()
+		mockRepository.EXPECT().
+			GetKnownDeviceList(gomock.Any(), gomock.Any()).
+			AnyTimes().
+			Return(nil, nil)
+		mockRepository.EXPECT().
+			CreateKnownDevice(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		mockRepository.EXPECT().
+			GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+			Times(5).
+			Return(user, nil)
+		mockRepository.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := service.Login(context.Background(), newLoginParams(user.PhoneNumber, util.CreateRandomString(10)))
+			require.Equal(t, err, errWrongPassword)
+		}
+
+		_, err := service.Login(context.Background(), newLoginParams(user.PhoneNumber, password))
+		require.Empty(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err := service.Login(context.Background(), newLoginParams(user.PhoneNumber, util.CreateRandomString(10)))
+			require.Equal(t, err, errWrongPassword)
+		}
+	})
+
+	t.Run("2단계 인증 회원은 비밀번호 로그인으로 실패 기록을 초기화하지 않음", func(t *testing.T) {
+		user, password := createRandomUser(t)
+		twoFactorUser, _ := createRandomTwoFactorUser(t, user)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepository := mockrepository.NewMockRepository(ctrl)
+		service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+		mockRepository.EXPECT().
+			CreateAuthEvent(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		mockRepository.EXPECT().
+			GetUser(gomock.Any(), gomock.Eq(twoFactorUser.PhoneNumber)).
+			Times(4).
+			Return(twoFactorUser, nil)
+		mockRepository.EXPECT().
+			CreateSession(gomock.Any(), gomock.Any()).
+			Times(0)
+
+		for i := 0; i < 2; i++ {
+			_, err := service.Login(context.Background(), newLoginParams(twoFactorUser.PhoneNumber, util.CreateRandomString(10)))
+			require.Equal(t, err, errWrongPassword)
+		}
+
+		// 비밀번호 로그인은 2단계 인증 대기 토큰만 발급
+		result, err := service.Login(context.Background(), newLoginParams(twoFactorUser.PhoneNumber, password))
+		require.Empty(t, err)
+		require.True(t, result.TwoFactorRequired)
+
+		// 실패 기록이 유지되어 다음 실패에 잠금
+		_, err = service.Login(context.Background(), newLoginParams(twoFactorUser.PhoneNumber, util.CreateRandomString(10)))
+		require.Equal(t, err.Code, http.StatusLocked)
+	})
+}
+
+func TestLoginSessionLimit(t *testing.T) {
+	user, password := createRandomUser(t)
+
+	createSessionList := func(n int) []repository.Session {
+		sessionList := []repository.Session{}
+		// 최신순 정렬
+		for i := 0; i < n; i++ {
+			id := util.CreateRandomString(36)
+			sessionList = append(sessionList, repository.Session{
+				ID:        id,
+				UserID:    user.ID,
+				FamilyID:  id,
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+				CreatedAt: time.Now().Add(-time.Duration(i) * time.Minute),
+			})
+		}
+		return sessionList
+	}
 
 	testCases := []struct {
 		name          string
-		params        func(refreshToken string) RenewAccessTokenParams
-		buildStubs    func(mockRepository *mockrepository.MockRepository) string
+		policy        string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
 		checkResponse func(result dto.LoginResponseBody, err CustomErr)
 	}{
 		{
-			name: "성공",
-			params: func(refreshToken string) RenewAccessTokenParams {
-				return RenewAccessTokenParams{
-					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
-						RefreshToken: refreshToken,
-					},
-					UserAgent: userAgent,
-					ClientIp:  clientIp,
-				}
-			},
-			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
-
+			name:   "최대 세션 수 미만",
+			policy: sessionLimitPolicyEvict,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
 				mockRepository.EXPECT().
-					GetSession(gomock.Any(), gomock.Any()).
+					GetActiveSessionList(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
-					Return(repository.Session{
-						ID:           refreshPayload.ID,
-						UserID:       user.ID,
-						RefreshToken: refreshToken,
-						UserAgent:    userAgent,
-						ClientIp:     clientIp,
-						IsBlocked:    false,
-						ExpiredAt:    refreshPayload.ExpiredAt,
-					}, nil)
-
-				return refreshToken
+					Return(createSessionList(1), nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
-				payload, _ := jwt.VerifyToken(result.AccessToken, testConfig.JWTSecret)
-				require.Equal(t, user.ID, payload.UserID)
+				require.NotEmpty(t, result.AccessToken)
+				require.Empty(t, result.EvictedSessionList)
 				require.Empty(t, err)
 			},
 		},
 		{
-			name: "유효하지 않은 refresh 토큰",
-			params: func(refreshToken string) RenewAccessTokenParams {
-				return RenewAccessTokenParams{
-					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
-						RefreshToken: refreshToken,
-					},
-					UserAgent: userAgent,
-					ClientIp:  clientIp,
-				}
-			},
-			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken := util.CreateRandomString(50)
+			name:   "evict 정책에서 최대 세션 수 초과 시 가장 오래된 세션 로그아웃",
+			policy: sessionLimitPolicyEvict,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				sessionList := createSessionList(2)
+				oldestSession := sessionList[1]
 
 				mockRepository.EXPECT().
-					GetSession(gomock.Any(), gomock.Any()).
+					GetActiveSessionList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(sessionList, nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(oldestSession.FamilyID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sessionList, nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
+				require.Len(t, result.EvictedSessionList, 1)
+				require.Equal(t, userAgent, result.EvictedSessionList[0].UserAgent)
+				require.Equal(t, clientIp, result.EvictedSessionList[0].ClientIp)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name:   "refuse 정책에서 최대 세션 수 초과 시 로그인 거부",
+			policy: sessionLimitPolicyRefuse,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetActiveSessionList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(createSessionList(2), nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errTooManySessions)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			policy: sessionLimitPolicyEvict,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetActiveSessionList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig
+			config.SessionMaxActive = 2
+			config.SessionLimitPolicy = tc.policy
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+			mockRepository.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+				Times(1).
+				Return(user, nil)
+			tc.buildStubs(mockRepository)
+			mockRepository.EXPECT().
+				GetKnownDeviceList(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(nil, nil)
+			mockRepository.EXPECT().
+				CreateKnownDevice(gomock.Any(), gomock.Any()).
+				AnyTimes()
+			mockRepository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			params := LoginParams{
+				LoginRequestBody: dto.LoginRequestBody{
+					PhoneNumber: user.PhoneNumber,
+					Password:    password,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			}
+
+			result, err := service.Login(context.Background(), params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestRenewAccessToken(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		params        func(refreshToken string) RenewAccessTokenParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository) string
+		checkResponse func(result dto.LoginResponseBody, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						FamilyID:     refreshPayload.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Eq(refreshPayload.ID), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, arg repository.CreateSessionParams) (int64, error) {
+						require.NotEqual(t, refreshPayload.ID, arg.ID)
+						require.Equal(t, refreshPayload.ID, arg.FamilyID)
+						require.NotEqual(t, refreshToken, arg.RefreshToken)
+						require.WithinDuration(t, refreshPayload.ExpiredAt, arg.ExpiredAt, time.Second)
+						return int64(1), nil
+					})
+
+				return refreshToken
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				payload, _ := testTokenMaker.VerifyToken(result.AccessToken)
+				require.Equal(t, user.ID, payload.UserID)
+				refreshPayload, _ := testTokenMaker.VerifyToken(result.RefreshToken)
+				require.Equal(t, user.ID, refreshPayload.UserID)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "유효하지 않은 refresh 토큰",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken := util.CreateRandomString(50)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
 					Times(0)
 
 				return refreshToken
 			},
 			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
 				require.Empty(t, result)
-				require.Equal(t, err, NewErrBadRequest(jwt.ErrInvalidToken))
+				require.Equal(t, err, NewErrBadRequest(token.ErrInvalidToken))
 			},
 		},
 		{
@@ -322,7 +906,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, _, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, -time.Minute)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(user.ID, "", -time.Minute)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -332,7 +916,32 @@ func TestRenewAccessToken(t *testing.T) {
 			},
 			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
 				require.Empty(t, result)
-				require.Equal(t, err, NewErrBadRequest(jwt.ErrExpiredToken))
+				require.Equal(t, err, NewErrBadRequest(token.ErrExpiredToken))
+			},
+		},
+		{
+			name: "refresh 토큰이 아닌 경우",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				accessToken, _, _ := testTokenMaker.CreateToken(user.ID, "", "", testConfig.AccessTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return accessToken
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrBadRequest(token.ErrInvalidToken))
 			},
 		},
 		{
@@ -347,7 +956,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, _, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -373,7 +982,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -407,7 +1016,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -441,7 +1050,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -475,7 +1084,7 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, refreshPayload, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
@@ -498,7 +1107,7 @@ func TestRenewAccessToken(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error",
+			name: "이미 재발급에 사용된 refresh 토큰인 경우",
 			params: func(refreshToken string) RenewAccessTokenParams {
 				return RenewAccessTokenParams{
 					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
@@ -509,48 +1118,963 @@ func TestRenewAccessToken(t *testing.T) {
 				}
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
-				refreshToken, _, _ := jwt.CreateToken(user.ID, testConfig.JWTSecret, testConfig.RefreshTokenDuration)
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+				familyID := util.CreateRandomString(36)
 
 				mockRepository.EXPECT().
 					GetSession(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(repository.Session{}, sql.ErrConnDone)
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						FamilyID:     familyID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						IsRotated:    true,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
 
 				return refreshToken
 			},
 			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
 				require.Empty(t, result)
-				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+				require.Equal(t, err, errReusedRefreshToken)
 			},
 		},
-	}
-
-	for i := range testCases {
-		tc := testCases[i]
+		{
+			name: "동시에 같은 refresh 토큰으로 재발급한 경우",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						FamilyID:     refreshPayload.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Eq(refreshPayload.ID), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
 
-			repository := mockrepository.NewMockRepository(ctrl)
-			service := newTestService(t, repository)
+				return refreshToken
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errReusedRefreshToken)
+			},
+		},
+		{
+			name: "새 세션 저장 실패",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
 
-			refreshToken := tc.buildStubs(repository)
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						FamilyID:     refreshPayload.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				// 트랜잭션이 롤백되어 재시도가 재사용으로 감지되지 않음
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Eq(refreshPayload.ID), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
 
-			result, err := service.RenewAccessToken(context.Background(), tc.params(refreshToken))
-			tc.checkResponse(result, err)
+				return refreshToken
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: func(refreshToken string) RenewAccessTokenParams {
+				return RenewAccessTokenParams{
+					RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+						RefreshToken: refreshToken,
+					},
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{}, sql.ErrConnDone)
+
+				return refreshToken
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			refreshToken := tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			result, err := service.RenewAccessToken(context.Background(), tc.params(refreshToken))
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestRenewAccessTokenClientBinding(t *testing.T) {
+	user, _ := createRandomUser(t)
+	otherUserAgent := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	if otherUserAgent == userAgent {
+		otherUserAgent += " Edg/118.0"
+	}
+
+	testCases := []struct {
+		name          string
+		policy        string
+		buildStubs    func(mockRepository *mockrepository.MockRepository, session repository.Session)
+		checkResponse func(result dto.LoginResponseBody, err CustomErr)
+	}{
+		{
+			name:   "strict 정책에서 다른 기기로 재발급한 경우 세션 차단",
+			policy: refreshTokenBindingStrict,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, session repository.Session) {
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{session}, nil)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errMismatchedSessionClient)
+			},
+		},
+		{
+			name:   "lenient 정책에서 다른 기기로 재발급한 경우 경고만 기록",
+			policy: refreshTokenBindingLenient,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, session repository.Session) {
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Eq(session.ID), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
+				require.NotEmpty(t, result.RefreshToken)
+				require.Empty(t, err)
+			},
+		},
+		{
+			name:   "off 정책에서 다른 기기로 재발급한 경우",
+			policy: refreshTokenBindingOff,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, session repository.Session) {
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Eq(session.ID), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.NotEmpty(t, result.AccessToken)
+				require.NotEmpty(t, result.RefreshToken)
+				require.Empty(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			config := testConfig
+			config.RefreshTokenBinding = tc.policy
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+			refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+			session := repository.Session{
+				ID:           refreshPayload.ID,
+				UserID:       user.ID,
+				FamilyID:     refreshPayload.ID,
+				RefreshToken: refreshToken,
+				UserAgent:    userAgent,
+				ClientIp:     clientIp,
+				IsBlocked:    false,
+				ExpiredAt:    refreshPayload.ExpiredAt,
+				CreatedAt:    time.Now(),
+			}
+
+			mockRepository.EXPECT().
+				GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+				Times(1).
+				Return(session, nil)
+			tc.buildStubs(mockRepository, session)
+			mockRepository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			params := RenewAccessTokenParams{
+				RenewAccessTokenRequestBody: dto.RenewAccessTokenRequestBody{
+					RefreshToken: refreshToken,
+				},
+				UserAgent: otherUserAgent,
+				ClientIp:  clientIp,
+			}
+
+			result, err := service.RenewAccessToken(context.Background(), params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository) string
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "유효하지 않은 refresh 토큰",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return util.CreateRandomString(50)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrBadRequest(token.ErrInvalidToken))
+			},
+		},
+		{
+			name: "세션이 없는 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, _, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundSession)
+			},
+		},
+		{
+			name: "세션 회원이 아닌 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       util.CreateRandomInt64(11, 20),
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errIncorrectSessionUser)
+			},
+		},
+		{
+			name: "refresh 토큰이 일치하지 않는 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						RefreshToken: util.CreateRandomString(50),
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errMismatchedSessionToken)
+			},
+		},
+		{
+			name: "이미 재발급에 사용된 refresh 토큰인 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+				familyID := util.CreateRandomString(36)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						FamilyID:     familyID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						IsRotated:    true,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errReusedRefreshToken)
+			},
+		},
+		{
+			name: "이미 막힌 세션인 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    true,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) string {
+				refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(user.ID, "", testConfig.RefreshTokenDuration)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{
+						ID:           refreshPayload.ID,
+						UserID:       user.ID,
+						RefreshToken: refreshToken,
+						UserAgent:    userAgent,
+						ClientIp:     clientIp,
+						IsBlocked:    false,
+						ExpiredAt:    refreshPayload.ExpiredAt,
+					}, nil)
+				mockRepository.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+
+				return refreshToken
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			refreshToken := tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			err := service.Logout(context.Background(), LogoutParams{LogoutRequestBody: dto.LogoutRequestBody{RefreshToken: refreshToken}})
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	user, password := createRandomUser(t)
+	session := createRandomSession(t, user)
+	newPassword := util.CreateRandomPassword()
+
+	testCases := []struct {
+		name          string
+		params        ChangePasswordParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.UpdateUserPasswordParams) error {
+						require.Equal(t, arg.ID, user.ID)
+						require.NoError(t, testPasswordHasher.CheckPassword(newPassword, arg.HashedPassword))
+						return nil
+					})
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(session.ID)).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Eq(repository.BlockOtherSessionsParams{
+						UserID:   user.ID,
+						FamilyID: session.FamilyID,
+					})).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{session}, nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "세션 정보가 없는 토큰인 경우 전체 세션 차단",
+			params: ChangePasswordParams{
+				UserID: user.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "회원이 없는 경우",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "현재 비밀번호가 틀린 경우",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: util.CreateRandomString(10),
+					NewPassword:     newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongPassword)
+			},
+		},
+		{
+			name: "현재 비밀번호와 같은 경우",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     password,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errSamePassword)
+			},
+		},
+		{
+			name: "휴대폰 번호가 포함된 경우",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     "Pw" + user.PhoneNumber,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrBadRequest(validator.ErrPasswordPhoneNumber("password")))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: ChangePasswordParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				ChangePasswordRequestBody: dto.ChangePasswordRequestBody{
+					CurrentPassword: password,
+					NewPassword:     newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			err := service.ChangePassword(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	user, _ := createRandomUser(t)
+	verification, code := createRandomVerification(t, user.PhoneNumber, repository.VerificationPurposePasswordReset)
+	newPassword := util.CreateRandomPassword()
+
+	testCases := []struct {
+		name          string
+		params        ResetPasswordParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: ResetPasswordParams{
+				ResetPasswordRequestBody: dto.ResetPasswordRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					VerificationCode: code,
+					NewPassword:      newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Eq(repository.GetLatestVerificationParams{
+						PhoneNumber: user.PhoneNumber,
+						Purpose:     repository.VerificationPurposePasswordReset,
+					})).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.UpdateUserPasswordParams) error {
+						require.Equal(t, arg.ID, user.ID)
+						require.NoError(t, testPasswordHasher.CheckPassword(newPassword, arg.HashedPassword))
+						return nil
+					})
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+				mockRepository.EXPECT().
+					CreateAuthEvent(gomock.Any(), gomock.Eq(repository.CreateAuthEventParams{
+						UserID:      sql.NullInt64{Int64: user.ID, Valid: true},
+						PhoneNumber: sql.NullString{String: maskPhoneNumber(user.PhoneNumber), Valid: true},
+						Type:        repository.AuthEventTypeResetPassword,
+						Outcome:     repository.AuthEventOutcomeSuccess,
+					})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			params: ResetPasswordParams{
+				ResetPasswordRequestBody: dto.ResetPasswordRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					VerificationCode: code,
+					NewPassword:      newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "인증번호 불일치",
+			params: ResetPasswordParams{
+				ResetPasswordRequestBody: dto.ResetPasswordRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					VerificationCode: createWrongVerificationCode(code),
+					NewPassword:      newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					IncreaseVerificationAttempt(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongVerificationCode)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: ResetPasswordParams{
+				ResetPasswordRequestBody: dto.ResetPasswordRequestBody{
+					PhoneNumber:      user.PhoneNumber,
+					VerificationCode: code,
+					NewPassword:      newPassword,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			err := service.ResetPassword(context.Background(), tc.params)
+			tc.checkResponse(err)
 		})
 	}
 }
 
 func createRandomUser(t *testing.T) (repository.User, string) {
 	password := util.CreateRandomString(10)
-	hashedPassword, _ := bcrypt.HashPassword(password)
+	hashedPassword, _ := testPasswordHasher.HashPassword(password)
 
 	user := repository.User{
 		ID:             util.CreateRandomInt64(1, 10),
 		PhoneNumber:    util.CreateRandomPhoneNumber(),
 		HashedPassword: hashedPassword,
+		Role:           repository.UserRoleOwner,
+		Timezone:       "Asia/Seoul",
 		CreatedAt:      time.Now(),
 	}
 
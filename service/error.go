@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
@@ -10,24 +11,80 @@ import (
 type CustomErr struct {
 	Code int
 	Err  error
+	// 0보다 큰 경우 Retry-After 헤더로 전달
+	RetryAfter time.Duration
 }
 
 var (
-	errDuplicatePhoneNumber   = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("duplicate phone number")}
-	errNotFoundUser           = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found user")}
-	errWrongPassword          = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("wrong password")}
-	errNotFoundSession        = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found session")}
-	errBlockedSession         = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("blocked session")}
-	errIncorrectSessionUser   = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("incorrect session user")}
-	errMismatchedSessionToken = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("mismatched session token")}
-	errExpiredSession         = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("expired session")}
+	errDuplicatePhoneNumber    = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("duplicate phone number")}
+	errNotFoundUser            = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found user")}
+	errWithdrawnUser           = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("already withdrawn user")}
+	errWrongPassword           = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("wrong password")}
+	errSamePassword            = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("new password should be different from current password")}
+	errNotFoundSession         = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found session")}
+	errBlockedSession          = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("blocked session")}
+	errIncorrectSessionUser    = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("incorrect session user")}
+	errMismatchedSessionToken  = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("mismatched session token")}
+	errExpiredSession          = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("expired session")}
+	errReusedRefreshToken      = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("reused refresh token")}
+	errMismatchedSessionClient = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("mismatched session client")}
+	errForbiddenSession        = CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only delete your session")}
+	errTooManySessions         = CustomErr{Code: http.StatusConflict, Err: fmt.Errorf("too many active sessions")}
+
+	errEnabledTwoFactor      = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("two factor authentication is already enabled")}
+	errNotEnrolledTwoFactor  = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("two factor authentication is not enrolled")}
+	errWrongTwoFactorCode    = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("wrong two factor code")}
+	errInvalidTwoFactorToken = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid two factor token")}
+
+	errNotFoundVerification        = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("verification code is not requested")}
+	errUsedVerification            = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("verification code is already used")}
+	errExpiredVerification         = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("verification code has expired")}
+	errWrongVerificationCode       = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("wrong verification code")}
+	errTooManyVerificationAttempts = CustomErr{Code: http.StatusTooManyRequests, Err: fmt.Errorf("too many verification attempts")}
+
+	errNotFoundApiKey = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found api key")}
+	errInvalidApiKey  = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("invalid api key")}
+	errRevokedApiKey  = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("revoked api key")}
+	errExpiredApiKey  = CustomErr{Code: http.StatusUnauthorized, Err: fmt.Errorf("expired api key")}
+
+	errNotFoundStore            = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found store")}
+	errForbiddenStore           = CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only access your store")}
+	errForbiddenStoreRole       = CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("store owner permission required")}
+	errNotFoundStoreMember      = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found store member")}
+	errDuplicateStoreMember     = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("already store member")}
+	errRemoveSelfStoreMember    = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("cannot remove yourself from store")}
+	errNotFoundStoreInvitation  = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found store invitation")}
+	errForbiddenStoreInvitation = CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only accept your store invitation")}
+	errAcceptedStoreInvitation  = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("store invitation is already accepted")}
+	errExpiredStoreInvitation   = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("store invitation has expired")}
 
 	errParseDate        = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("invalid date format")}
 	errNotFoundProduct  = CustomErr{Code: http.StatusNotFound, Err: fmt.Errorf("not found product")}
 	errForbiddenProduct = CustomErr{Code: http.StatusForbidden, Err: fmt.Errorf("only get your product")}
 	errDuplicateBarcode = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("duplicate barcode")}
+	errPageOutOfRange   = CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("page is out of range")}
 )
 
+func errLockedAccount(retryAfter time.Duration) CustomErr {
+	return CustomErr{Code: http.StatusLocked, Err: fmt.Errorf("account is locked"), RetryAfter: retryAfter}
+}
+
+func errPageSizeTooLarge(maxSize int32) CustomErr {
+	return CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("size should be smaller than or equals to %d", maxSize)}
+}
+
+func errInvalidRange(from string, to string) CustomErr {
+	return CustomErr{Code: http.StatusBadRequest, Err: fmt.Errorf("%s should be smaller than or equals to %s", from, to)}
+}
+
+func errTooManyLoginAttempts(retryAfter time.Duration) CustomErr {
+	return CustomErr{Code: http.StatusTooManyRequests, Err: fmt.Errorf("too many login attempts"), RetryAfter: retryAfter}
+}
+
+func errTooManyVerificationRequests(retryAfter time.Duration) CustomErr {
+	return CustomErr{Code: http.StatusTooManyRequests, Err: fmt.Errorf("too many verification code requests"), RetryAfter: retryAfter}
+}
+
 func NewErrInternalServer(err error) CustomErr {
 	log.Error().Msg(err.Error())
 	return CustomErr{Code: http.StatusInternalServerError, Err: fmt.Errorf("internal server error")}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/token"
+)
+
+// 토큰 종류 (RFC 7662 token_type_hint 값)
+const (
+	tokenTypeAccess  = "access_token"
+	tokenTypeRefresh = "refresh_token"
+)
+
+// 토큰 검사 시 세션 상태
+const (
+	sessionStatusActive   = "active"
+	sessionStatusNotFound = "not_found"
+	sessionStatusBlocked  = "blocked"
+	sessionStatusRotated  = "rotated"
+	sessionStatusExpired  = "expired"
+	sessionStatusRevoked  = "revoked"
+)
+
+type IntrospectTokenParams = dto.IntrospectTokenRequestBody
+
+// 토큰 검사 로직
+// 토큰 자체 검증과 세션 검증을 모두 통과한 경우에만 active
+func (service *service) IntrospectToken(ctx context.Context, params IntrospectTokenParams) (result dto.IntrospectTokenResponse, cErr CustomErr) {
+	// 토큰 검증
+	// 2단계 인증 대기 토큰 등 용도가 지정된 토큰은 access, refresh 토큰이 아니므로 제외
+	payload, err := service.tokenMaker.VerifyToken(params.Token)
+	if err != nil || payload.Purpose != "" {
+		return
+	}
+
+	// refresh 토큰은 세션 ID 자체, access 토큰은 발급한 세션 ID를 가짐
+	var tokenType, sessionID string
+	switch payload.Kind {
+	case token.KindRefresh:
+		tokenType, sessionID = tokenTypeRefresh, payload.ID
+	case token.KindAccess:
+		tokenType, sessionID = tokenTypeAccess, payload.SessionID
+	default:
+		return
+	}
+
+	// 세션 검색 및 검증
+	session, cErr := service.getSession(ctx, sessionID, payload.UserID)
+	if cErr.Err != nil {
+		switch cErr {
+		case errNotFoundSession:
+			result.SessionStatus = sessionStatusNotFound
+		case errBlockedSession:
+			result.SessionStatus = sessionStatusBlocked
+		case errIncorrectSessionUser:
+		default:
+			return
+		}
+
+		cErr = CustomErr{}
+		return
+	}
+
+	result.SessionStatus = introspectSessionStatus(session, tokenType, params.Token)
+	if result.SessionStatus != sessionStatusActive {
+		return
+	}
+
+	// 폐기된 access 토큰 검증
+	if tokenType == tokenTypeAccess {
+		revoked, err := revocation.IsPayloadRevoked(ctx, service.revocationStore, payload)
+		if err != nil {
+			cErr = NewErrInternalServer(err)
+			return
+		}
+		if revoked {
+			result.SessionStatus = sessionStatusRevoked
+			return
+		}
+	}
+
+	result = dto.IntrospectTokenResponse{
+		Active:        true,
+		TokenType:     tokenType,
+		UserID:        payload.UserID,
+		Role:          payload.Role,
+		SessionID:     session.ID,
+		SessionStatus: result.SessionStatus,
+		IssuedAt:      payload.IssuedAt.Unix(),
+		ExpiredAt:     payload.ExpiredAt.Unix(),
+	}
+
+	return
+}
+
+// 토큰 종류별 세션 상태
+// access 토큰은 세션이 재발급(교체)되어도 만료 전까지 유효
+func introspectSessionStatus(session repository.Session, tokenType string, tokenString string) string {
+	if tokenType == tokenTypeAccess {
+		return sessionStatusActive
+	}
+
+	// refresh 토큰이 일치하지 않는 경우
+	if session.RefreshToken != tokenString {
+		return sessionStatusNotFound
+	}
+	// 이미 재발급에 사용된 refresh 토큰인 경우
+	if session.IsRotated {
+		return sessionStatusRotated
+	}
+	// 세션이 만료된 경우
+	if time.Now().After(session.ExpiredAt) {
+		return sessionStatusExpired
+	}
+
+	return sessionStatusActive
+}
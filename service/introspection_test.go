@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospectToken(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	refreshToken, refreshPayload, _ := testTokenMaker.CreateRefreshToken(userID, "", testConfig.RefreshTokenDuration)
+	accessToken, accessPayload, _ := testTokenMaker.CreateToken(userID, "", refreshPayload.ID, testConfig.AccessTokenDuration)
+
+	newSession := func() repository.Session {
+		return repository.Session{
+			ID:           refreshPayload.ID,
+			UserID:       userID,
+			FamilyID:     refreshPayload.ID,
+			RefreshToken: refreshToken,
+			UserAgent:    userAgent,
+			ClientIp:     clientIp,
+			ExpiredAt:    refreshPayload.ExpiredAt,
+			CreatedAt:    time.Now(),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		token         string
+		buildStubs    func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store)
+		checkResponse func(result dto.IntrospectTokenResponse, err CustomErr)
+	}{
+		{
+			name:  "access 토큰",
+			token: accessToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				// access 토큰은 세션이 교체되어도 유효
+				session := newSession()
+				session.IsRotated = true
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, dto.IntrospectTokenResponse{
+					Active:        true,
+					TokenType:     tokenTypeAccess,
+					UserID:        userID,
+					SessionID:     refreshPayload.ID,
+					SessionStatus: sessionStatusActive,
+					IssuedAt:      accessPayload.IssuedAt.Unix(),
+					ExpiredAt:     accessPayload.ExpiredAt.Unix(),
+				}, result)
+			},
+		},
+		{
+			name:  "refresh 토큰",
+			token: refreshToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(newSession(), nil)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.True(t, result.Active)
+				require.Equal(t, tokenTypeRefresh, result.TokenType)
+				require.Equal(t, refreshPayload.ExpiredAt.Unix(), result.ExpiredAt)
+			},
+		},
+		{
+			name:  "이미 재발급에 사용된 refresh 토큰",
+			token: refreshToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				session := newSession()
+				session.IsRotated = true
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, dto.IntrospectTokenResponse{SessionStatus: sessionStatusRotated}, result)
+			},
+		},
+		{
+			name:  "세션이 막힌 경우",
+			token: accessToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				session := newSession()
+				session.IsBlocked = true
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, dto.IntrospectTokenResponse{SessionStatus: sessionStatusBlocked}, result)
+			},
+		},
+		{
+			name:  "세션이 없는 경우",
+			token: accessToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(repository.Session{}, sql.ErrNoRows)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, dto.IntrospectTokenResponse{SessionStatus: sessionStatusNotFound}, result)
+			},
+		},
+		{
+			name:  "폐기된 access 토큰",
+			token: accessToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				err := revocationStore.Revoke(context.Background(), refreshPayload.ID, accessPayload.ExpiredAt)
+				require.NoError(t, err)
+
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(newSession(), nil)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, dto.IntrospectTokenResponse{SessionStatus: sessionStatusRevoked}, result)
+			},
+		},
+		{
+			name:  "유효하지 않은 토큰",
+			token: util.CreateRandomString(50),
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Empty(t, result)
+			},
+		},
+		{
+			name: "용도가 지정된 토큰",
+			token: func() string {
+				twoFactorToken, _, _ := testTokenMaker.CreatePurposeToken(userID, token.PurposeTwoFactor, testConfig.TwoFactorTokenDuration)
+				return twoFactorToken
+			}(),
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Empty(t, result)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			token: accessToken,
+			buildStubs: func(mockRepository *mockrepository.MockRepository, revocationStore revocation.Store) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.IntrospectTokenResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			revocationStore := revocation.NewMemoryStore()
+			service := NewService(testConfig, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocationStore, sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+			tc.buildStubs(mockRepository, revocationStore)
+
+			result, err := service.IntrospectToken(context.Background(), IntrospectTokenParams{Token: tc.token})
+			tc.checkResponse(result, err)
+		})
+	}
+}
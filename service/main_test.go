@@ -6,14 +6,43 @@ import (
 
 	"github.com/gitaepark/pha/repository"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
+	"github.com/gitaepark/pha/util/hasher"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/gitaepark/pha/util/token"
 )
 
 var testConfig = util.Config{
 	JWTSecret:            util.CreateRandomString(32),
 	AccessTokenDuration:  time.Minute,
 	RefreshTokenDuration: time.Minute,
+
+	UserWithdrawalGracePeriod: time.Hour,
+	SessionRetentionPeriod:    24 * time.Hour,
+
+	TOTPIssuer:             "pha",
+	TwoFactorTokenDuration: time.Minute,
+
+	StoreInvitationDuration: time.Hour,
+
+	PageMaxSize:  100,
+	CursorSecret: util.CreateRandomString(32),
+
+	// 테스트 속도를 위해 낮은 파라미터 사용
+	Argon2Memory:      1024,
+	Argon2Iterations:  1,
+	Argon2Parallelism: 1,
 }
 
+var testTokenMaker, _ = token.NewTokenMaker(testConfig)
+
+var testPasswordHasher, _ = hasher.NewPasswordHasher(testConfig)
+
+var testCursorSigner, _ = cursor.NewSigner(testConfig.CursorSecret)
+
 func newTestService(t *testing.T, repository repository.Repository) Service {
-	return NewService(testConfig, repository)
+	return NewService(testConfig, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, repository)
 }
@@ -10,6 +10,7 @@ import (
 
 	dto "github.com/gitaepark/pha/dto"
 	service "github.com/gitaepark/pha/service"
+	token "github.com/gitaepark/pha/util/token"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +37,63 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptStoreInvitation mocks base method.
+func (m *MockService) AcceptStoreInvitation(arg0 context.Context, arg1 service.AcceptStoreInvitationParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptStoreInvitation", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// AcceptStoreInvitation indicates an expected call of AcceptStoreInvitation.
+func (mr *MockServiceMockRecorder) AcceptStoreInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptStoreInvitation", reflect.TypeOf((*MockService)(nil).AcceptStoreInvitation), arg0, arg1)
+}
+
+// ChangePassword mocks base method.
+func (m *MockService) ChangePassword(arg0 context.Context, arg1 service.ChangePasswordParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockServiceMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockService)(nil).ChangePassword), arg0, arg1)
+}
+
+// ConfirmTwoFactor mocks base method.
+func (m *MockService) ConfirmTwoFactor(arg0 context.Context, arg1 service.ConfirmTwoFactorParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor.
+func (mr *MockServiceMockRecorder) ConfirmTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockService)(nil).ConfirmTwoFactor), arg0, arg1)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockService) CreateApiKey(arg0 context.Context, arg1 service.CreateApiKeyParams) (dto.CreateApiKeyResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", arg0, arg1)
+	ret0, _ := ret[0].(dto.CreateApiKeyResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockServiceMockRecorder) CreateApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockService)(nil).CreateApiKey), arg0, arg1)
+}
+
 // CreateProduct mocks base method.
 func (m *MockService) CreateProduct(arg0 context.Context, arg1 service.CreateProductParams) service.CustomErr {
 	m.ctrl.T.Helper()
@@ -50,6 +108,35 @@ func (mr *MockServiceMockRecorder) CreateProduct(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockService)(nil).CreateProduct), arg0, arg1)
 }
 
+// CreateStore mocks base method.
+func (m *MockService) CreateStore(arg0 context.Context, arg1 service.CreateStoreParams) (dto.CreateStoreResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStore", arg0, arg1)
+	ret0, _ := ret[0].(dto.CreateStoreResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// CreateStore indicates an expected call of CreateStore.
+func (mr *MockServiceMockRecorder) CreateStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStore", reflect.TypeOf((*MockService)(nil).CreateStore), arg0, arg1)
+}
+
+// CreateStoreInvitation mocks base method.
+func (m *MockService) CreateStoreInvitation(arg0 context.Context, arg1 service.CreateStoreInvitationParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStoreInvitation", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// CreateStoreInvitation indicates an expected call of CreateStoreInvitation.
+func (mr *MockServiceMockRecorder) CreateStoreInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoreInvitation", reflect.TypeOf((*MockService)(nil).CreateStoreInvitation), arg0, arg1)
+}
+
 // DeleteProduct mocks base method.
 func (m *MockService) DeleteProduct(arg0 context.Context, arg1 service.DeleteProductParams) service.CustomErr {
 	m.ctrl.T.Helper()
@@ -64,6 +151,93 @@ func (mr *MockServiceMockRecorder) DeleteProduct(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockService)(nil).DeleteProduct), arg0, arg1)
 }
 
+// DeleteSession mocks base method.
+func (m *MockService) DeleteSession(arg0 context.Context, arg1 service.DeleteSessionParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockServiceMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockService)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteSessionList mocks base method.
+func (m *MockService) DeleteSessionList(arg0 context.Context, arg1 service.DeleteSessionListParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionList", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// DeleteSessionList indicates an expected call of DeleteSessionList.
+func (mr *MockServiceMockRecorder) DeleteSessionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionList", reflect.TypeOf((*MockService)(nil).DeleteSessionList), arg0, arg1)
+}
+
+// DeleteStoreMember mocks base method.
+func (m *MockService) DeleteStoreMember(arg0 context.Context, arg1 service.DeleteStoreMemberParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoreMember", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// DeleteStoreMember indicates an expected call of DeleteStoreMember.
+func (mr *MockServiceMockRecorder) DeleteStoreMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoreMember", reflect.TypeOf((*MockService)(nil).DeleteStoreMember), arg0, arg1)
+}
+
+// EnrollTwoFactor mocks base method.
+func (m *MockService) EnrollTwoFactor(arg0 context.Context, arg1 service.EnrollTwoFactorParams) (dto.EnrollTwoFactorResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(dto.EnrollTwoFactorResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// EnrollTwoFactor indicates an expected call of EnrollTwoFactor.
+func (mr *MockServiceMockRecorder) EnrollTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactor", reflect.TypeOf((*MockService)(nil).EnrollTwoFactor), arg0, arg1)
+}
+
+// GetApiKeyList mocks base method.
+func (m *MockService) GetApiKeyList(arg0 context.Context, arg1 service.GetApiKeyListParams) (dto.GetApiKeyListResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiKeyList", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetApiKeyListResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetApiKeyList indicates an expected call of GetApiKeyList.
+func (mr *MockServiceMockRecorder) GetApiKeyList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyList", reflect.TypeOf((*MockService)(nil).GetApiKeyList), arg0, arg1)
+}
+
+// GetAuthEventList mocks base method.
+func (m *MockService) GetAuthEventList(arg0 context.Context, arg1 service.GetAuthEventListParams) (dto.GetAuthEventListResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthEventList", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetAuthEventListResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetAuthEventList indicates an expected call of GetAuthEventList.
+func (mr *MockServiceMockRecorder) GetAuthEventList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthEventList", reflect.TypeOf((*MockService)(nil).GetAuthEventList), arg0, arg1)
+}
+
 // GetProduct mocks base method.
 func (m *MockService) GetProduct(arg0 context.Context, arg1 service.GetProductParams) (dto.GetProductResponse, service.CustomErr) {
 	m.ctrl.T.Helper()
@@ -94,6 +268,81 @@ func (mr *MockServiceMockRecorder) GetProductList(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductList", reflect.TypeOf((*MockService)(nil).GetProductList), arg0, arg1)
 }
 
+// GetSessionList mocks base method.
+func (m *MockService) GetSessionList(arg0 context.Context, arg1 service.GetSessionListParams) (dto.GetSessionListResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionList", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetSessionListResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetSessionList indicates an expected call of GetSessionList.
+func (mr *MockServiceMockRecorder) GetSessionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionList", reflect.TypeOf((*MockService)(nil).GetSessionList), arg0, arg1)
+}
+
+// GetStoreInvitationList mocks base method.
+func (m *MockService) GetStoreInvitationList(arg0 context.Context, arg1 service.GetStoreInvitationListParams) (dto.GetStoreInvitationListResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreInvitationList", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetStoreInvitationListResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetStoreInvitationList indicates an expected call of GetStoreInvitationList.
+func (mr *MockServiceMockRecorder) GetStoreInvitationList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreInvitationList", reflect.TypeOf((*MockService)(nil).GetStoreInvitationList), arg0, arg1)
+}
+
+// GetStoreList mocks base method.
+func (m *MockService) GetStoreList(arg0 context.Context, arg1 service.GetStoreListParams) (dto.GetStoreListResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoreList", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetStoreListResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetStoreList indicates an expected call of GetStoreList.
+func (mr *MockServiceMockRecorder) GetStoreList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoreList", reflect.TypeOf((*MockService)(nil).GetStoreList), arg0, arg1)
+}
+
+// GetUserProfile mocks base method.
+func (m *MockService) GetUserProfile(arg0 context.Context, arg1 service.GetUserProfileParams) (dto.GetUserProfileResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetUserProfileResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockServiceMockRecorder) GetUserProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockService)(nil).GetUserProfile), arg0, arg1)
+}
+
+// IntrospectToken mocks base method.
+func (m *MockService) IntrospectToken(arg0 context.Context, arg1 dto.IntrospectTokenRequestBody) (dto.IntrospectTokenResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IntrospectToken", arg0, arg1)
+	ret0, _ := ret[0].(dto.IntrospectTokenResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// IntrospectToken indicates an expected call of IntrospectToken.
+func (mr *MockServiceMockRecorder) IntrospectToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IntrospectToken", reflect.TypeOf((*MockService)(nil).IntrospectToken), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockService) Login(arg0 context.Context, arg1 service.LoginParams) (dto.LoginResponseBody, service.CustomErr) {
 	m.ctrl.T.Helper()
@@ -109,8 +358,67 @@ func (mr *MockServiceMockRecorder) Login(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockService)(nil).Login), arg0, arg1)
 }
 
+// LoginTwoFactor mocks base method.
+func (m *MockService) LoginTwoFactor(arg0 context.Context, arg1 service.LoginTwoFactorParams) (dto.LoginResponseBody, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginTwoFactor", arg0, arg1)
+	ret0, _ := ret[0].(dto.LoginResponseBody)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// LoginTwoFactor indicates an expected call of LoginTwoFactor.
+func (mr *MockServiceMockRecorder) LoginTwoFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginTwoFactor", reflect.TypeOf((*MockService)(nil).LoginTwoFactor), arg0, arg1)
+}
+
+// Logout mocks base method.
+func (m *MockService) Logout(arg0 context.Context, arg1 service.LogoutParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockServiceMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockService)(nil).Logout), arg0, arg1)
+}
+
+// PurgeSessions mocks base method.
+func (m *MockService) PurgeSessions(arg0 context.Context) (int64, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeSessions", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// PurgeSessions indicates an expected call of PurgeSessions.
+func (mr *MockServiceMockRecorder) PurgeSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSessions", reflect.TypeOf((*MockService)(nil).PurgeSessions), arg0)
+}
+
+// PurgeWithdrawnUsers mocks base method.
+func (m *MockService) PurgeWithdrawnUsers(arg0 context.Context) (int64, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWithdrawnUsers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// PurgeWithdrawnUsers indicates an expected call of PurgeWithdrawnUsers.
+func (mr *MockServiceMockRecorder) PurgeWithdrawnUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWithdrawnUsers", reflect.TypeOf((*MockService)(nil).PurgeWithdrawnUsers), arg0)
+}
+
 // Register mocks base method.
-func (m *MockService) Register(arg0 context.Context, arg1 dto.RegisterRequestBody) service.CustomErr {
+func (m *MockService) Register(arg0 context.Context, arg1 service.RegisterParams) service.CustomErr {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Register", arg0, arg1)
 	ret0, _ := ret[0].(service.CustomErr)
@@ -138,6 +446,48 @@ func (mr *MockServiceMockRecorder) RenewAccessToken(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccessToken", reflect.TypeOf((*MockService)(nil).RenewAccessToken), arg0, arg1)
 }
 
+// ResetPassword mocks base method.
+func (m *MockService) ResetPassword(arg0 context.Context, arg1 service.ResetPasswordParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockServiceMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockService)(nil).ResetPassword), arg0, arg1)
+}
+
+// RevokeApiKey mocks base method.
+func (m *MockService) RevokeApiKey(arg0 context.Context, arg1 service.RevokeApiKeyParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockServiceMockRecorder) RevokeApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockService)(nil).RevokeApiKey), arg0, arg1)
+}
+
+// SendVerificationCode mocks base method.
+func (m *MockService) SendVerificationCode(arg0 context.Context, arg1 dto.SendVerificationCodeRequestBody) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationCode", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// SendVerificationCode indicates an expected call of SendVerificationCode.
+func (mr *MockServiceMockRecorder) SendVerificationCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationCode", reflect.TypeOf((*MockService)(nil).SendVerificationCode), arg0, arg1)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockService) UpdateProduct(arg0 context.Context, arg1 service.UpdateProductParams) service.CustomErr {
 	m.ctrl.T.Helper()
@@ -151,3 +501,61 @@ func (mr *MockServiceMockRecorder) UpdateProduct(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockService)(nil).UpdateProduct), arg0, arg1)
 }
+
+// UpdateUserProfile mocks base method.
+func (m *MockService) UpdateUserProfile(arg0 context.Context, arg1 service.UpdateUserProfileParams) (dto.GetUserProfileResponse, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserProfile", arg0, arg1)
+	ret0, _ := ret[0].(dto.GetUserProfileResponse)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile.
+func (mr *MockServiceMockRecorder) UpdateUserProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockService)(nil).UpdateUserProfile), arg0, arg1)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockService) UpdateUserRole(arg0 context.Context, arg1 service.UpdateUserRoleParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockServiceMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockService)(nil).UpdateUserRole), arg0, arg1)
+}
+
+// VerifyApiKey mocks base method.
+func (m *MockService) VerifyApiKey(arg0 context.Context, arg1 string) (*token.Payload, service.CustomErr) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyApiKey", arg0, arg1)
+	ret0, _ := ret[0].(*token.Payload)
+	ret1, _ := ret[1].(service.CustomErr)
+	return ret0, ret1
+}
+
+// VerifyApiKey indicates an expected call of VerifyApiKey.
+func (mr *MockServiceMockRecorder) VerifyApiKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyApiKey", reflect.TypeOf((*MockService)(nil).VerifyApiKey), arg0, arg1)
+}
+
+// WithdrawUser mocks base method.
+func (m *MockService) WithdrawUser(arg0 context.Context, arg1 service.WithdrawUserParams) service.CustomErr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawUser", arg0, arg1)
+	ret0, _ := ret[0].(service.CustomErr)
+	return ret0
+}
+
+// WithdrawUser indicates an expected call of WithdrawUser.
+func (mr *MockServiceMockRecorder) WithdrawUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawUser", reflect.TypeOf((*MockService)(nil).WithdrawUser), arg0, arg1)
+}
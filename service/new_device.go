@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/device"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/rs/zerolog/log"
+)
+
+// 새 기기 로그인 사유
+const (
+	newDeviceReasonDevice        = "new device"
+	newDeviceReasonIpRange       = "new ip range"
+	newDeviceReasonDeviceIpRange = "new device and ip range"
+)
+
+// 새 기기, ip 대역 로그인 감지
+// 로그인 기기 기록(세션 정리와 무관하게 유지)과 비교하며, 새 기기가 아닌 경우 빈 문자열 반환
+func (service *service) detectNewDevice(ctx context.Context, userID int64, userAgent, clientIp string) string {
+	clientList, err := service.repository.GetKnownDeviceList(ctx, userID)
+	if err != nil {
+		log.Error().Int64("user_id", userID).Err(err).Msg("failed to detect new device")
+		return ""
+	}
+	// 첫 로그인은 비교할 기록이 없으므로 제외
+	if len(clientList) == 0 {
+		return ""
+	}
+
+	fingerprint := device.Fingerprint(userAgent)
+	ipRange := clientIpRange(clientIp)
+
+	isKnownDevice, isKnownIpRange := false, false
+	for _, client := range clientList {
+		if device.Fingerprint(client.UserAgent) == fingerprint {
+			isKnownDevice = true
+		}
+		if clientIpRange(client.ClientIp) == ipRange {
+			isKnownIpRange = true
+		}
+	}
+
+	switch {
+	case !isKnownDevice && !isKnownIpRange:
+		return newDeviceReasonDeviceIpRange
+	case !isKnownDevice:
+		return newDeviceReasonDevice
+	case !isKnownIpRange:
+		return newDeviceReasonIpRange
+	}
+
+	return ""
+}
+
+// 로그인 기기 기록
+// 기록에 실패해도 로그인은 진행
+func (service *service) rememberDevice(ctx context.Context, userID int64, userAgent, clientIp string) {
+	arg := repository.CreateKnownDeviceParams{
+		UserID:    userID,
+		UserAgent: truncateString(userAgent, userAgentMaxLength),
+		ClientIp:  clientIp,
+	}
+
+	err := service.repository.CreateKnownDevice(ctx, arg)
+	if err != nil {
+		log.Error().Int64("user_id", userID).Err(err).Msg("failed to remember device")
+	}
+}
+
+// 새 기기 로그인 기록 및 알림 발송
+// 알림 발송에 실패해도 로그인은 진행
+func (service *service) notifyNewDevice(ctx context.Context, user repository.User, userAgent, clientIp, reason string) {
+	service.recordAuthEvent(ctx, authEvent{
+		Type:        repository.AuthEventTypeNewDeviceLogin,
+		UserID:      user.ID,
+		PhoneNumber: user.PhoneNumber,
+		Reason:      reason,
+		UserAgent:   userAgent,
+		ClientIp:    clientIp,
+	}, CustomErr{})
+
+	loginDevice := device.Parse(userAgent)
+	notification := notifier.Notification{
+		UserID:      user.ID,
+		PhoneNumber: user.PhoneNumber,
+		Title:       "[pha] 새 기기 로그인",
+		Message:     fmt.Sprintf("새 기기(%s, %s, ip %s)에서 로그인했습니다. 본인이 아닌 경우 비밀번호를 변경해 주세요.", loginDevice.OS, loginDevice.Browser, clientIp),
+	}
+
+	err := service.notifier.Notify(ctx, notification)
+	if err != nil {
+		log.Error().Int64("user_id", user.ID).Err(err).Msg("failed to send new device notification")
+		return
+	}
+
+	log.Info().Int64("user_id", user.ID).Str("reason", reason).Msg("new device login detected")
+}
+
+// ip 대역 계산 함수 (IPv4 /24, IPv6 /48)
+func clientIpRange(clientIp string) string {
+	ip := net.ParseIP(clientIp)
+	if ip == nil {
+		return clientIp
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+
+	return ip.Mask(net.CIDRMask(48, 128)).String() + "/48"
+}
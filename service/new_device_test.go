@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectNewDevice(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	chrome118 := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	chrome119 := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36"
+	iPhone := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+
+	clientList := []repository.KnownDevice{
+		{UserID: userID, UserAgent: chrome118, ClientIp: "203.0.113.10"},
+	}
+
+	testCases := []struct {
+		name       string
+		userAgent  string
+		clientIp   string
+		buildStubs func(mockRepository *mockrepository.MockRepository)
+		reason     string
+	}{
+		{
+			name:      "같은 기기, 같은 ip 대역",
+			userAgent: chrome119,
+			clientIp:  "203.0.113.200",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(clientList, nil)
+			},
+			reason: "",
+		},
+		{
+			name:      "새 기기",
+			userAgent: iPhone,
+			clientIp:  "203.0.113.10",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(clientList, nil)
+			},
+			reason: newDeviceReasonDevice,
+		},
+		{
+			name:      "새 ip 대역",
+			userAgent: chrome118,
+			clientIp:  "198.51.100.10",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(clientList, nil)
+			},
+			reason: newDeviceReasonIpRange,
+		},
+		{
+			name:      "새 기기, 새 ip 대역",
+			userAgent: iPhone,
+			clientIp:  "198.51.100.10",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(clientList, nil)
+			},
+			reason: newDeviceReasonDeviceIpRange,
+		},
+		{
+			name:      "첫 로그인",
+			userAgent: iPhone,
+			clientIp:  "198.51.100.10",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return([]repository.KnownDevice{}, nil)
+			},
+			reason: "",
+		},
+		{
+			name:      "기기 기록 조회 실패",
+			userAgent: iPhone,
+			clientIp:  "198.51.100.10",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetKnownDeviceList(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			reason: "",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, mockRepository).(*service)
+
+			tc.buildStubs(mockRepository)
+
+			reason := service.detectNewDevice(context.Background(), userID, tc.userAgent, tc.clientIp)
+			require.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestRememberDevice(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	longUserAgent := util.CreateRandomString(userAgentMaxLength + 10)
+
+	testCases := []struct {
+		name       string
+		userAgent  string
+		buildStubs func(mockRepository *mockrepository.MockRepository)
+	}{
+		{
+			name:      "성공",
+			userAgent: userAgent,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.CreateKnownDeviceParams{
+					UserID:    userID,
+					UserAgent: userAgent,
+					ClientIp:  clientIp,
+				}
+
+				mockRepository.EXPECT().
+					CreateKnownDevice(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name:      "컬럼 길이를 넘는 user agent",
+			userAgent: longUserAgent,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.CreateKnownDeviceParams{
+					UserID:    userID,
+					UserAgent: string([]rune(longUserAgent)[:userAgentMaxLength]),
+					ClientIp:  clientIp,
+				}
+
+				mockRepository.EXPECT().
+					CreateKnownDevice(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name:      "기록 실패",
+			userAgent: userAgent,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateKnownDevice(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, mockRepository).(*service)
+
+			tc.buildStubs(mockRepository)
+
+			service.rememberDevice(context.Background(), userID, tc.userAgent, clientIp)
+		})
+	}
+}
+
+func TestNotifyNewDevice(t *testing.T) {
+	user, _ := createRandomUser(t)
+	user.ID = util.CreateRandomInt64(1, 10)
+	maskedPhoneNumber := user.PhoneNumber[:3] + "****" + user.PhoneNumber[7:]
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepository := mockrepository.NewMockRepository(ctrl)
+	path := filepath.Join(t.TempDir(), "notification.log")
+	service := NewService(testConfig, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewFileNotifier(path), testCursorSigner, mockRepository).(*service)
+
+	arg := repository.CreateAuthEventParams{
+		UserID:      sql.NullInt64{Int64: user.ID, Valid: true},
+		PhoneNumber: sql.NullString{String: maskedPhoneNumber, Valid: true},
+		Type:        repository.AuthEventTypeNewDeviceLogin,
+		Outcome:     repository.AuthEventOutcomeSuccess,
+		Reason:      sql.NullString{String: newDeviceReasonDevice, Valid: true},
+		ClientIp:    clientIp,
+		UserAgent:   userAgent,
+	}
+	mockRepository.EXPECT().
+		CreateAuthEvent(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(nil)
+
+	service.notifyNewDevice(context.Background(), user, userAgent, clientIp, newDeviceReasonDevice)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), fmt.Sprintf("\t%d\t", user.ID))
+	require.Contains(t, string(content), clientIp)
+}
+
+func TestClientIpRange(t *testing.T) {
+	require.Equal(t, "203.0.113.0/24", clientIpRange("203.0.113.10"))
+	require.Equal(t, clientIpRange("203.0.113.10"), clientIpRange("203.0.113.200"))
+	require.NotEqual(t, clientIpRange("203.0.113.10"), clientIpRange("203.0.114.10"))
+	require.Equal(t, "2001:db8:1::/48", clientIpRange("2001:db8:1:2::1"))
+	require.Equal(t, "unknown", clientIpRange("unknown"))
+}
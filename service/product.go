@@ -3,22 +3,38 @@ package service
 import (
 	"context"
 	"database/sql"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/gitaepark/pha/dto"
 	"github.com/gitaepark/pha/repository"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
 	"github.com/go-sql-driver/mysql"
 )
 
+// 상품 목록 기본, 최대 조회 개수
+// 최대 조회 개수는 PAGE_MAX_SIZE가 없거나 더 큰 경우에도 적용
+const (
+	productListDefaultSize = 10
+	productListMaxSize     = 1000
+)
+
 type CreateProductParams struct {
 	UserID int64
+	dto.StoreRequestHeader
 	dto.CreateProductRequestBody
 }
 
 // 상품 등록 로직
 func (service *service) CreateProduct(ctx context.Context, params CreateProductParams) (cErr CustomErr) {
+	// 매장 점주 확인
+	member, cErr := service.getStoreMember(ctx, params.UserID, params.StoreID, repository.StoreMemberRoleOwner)
+	if cErr.Err != nil {
+		return
+	}
+
 	// string 타입의 날짜 time 타입으로 변환
 	parsedTime, err := time.Parse(util.DateLayout, params.ExpirationDate)
 	if err != nil {
@@ -27,7 +43,7 @@ func (service *service) CreateProduct(ctx context.Context, params CreateProductP
 	}
 
 	arg := repository.CreateProductParams{
-		UserID:         params.UserID,
+		StoreID:        member.StoreID,
 		Category:       params.Category,
 		Price:          params.Price,
 		Cost:           params.Cost,
@@ -50,11 +66,11 @@ func (service *service) CreateProduct(ctx context.Context, params CreateProductP
 					cErr = errDuplicateBarcode
 					return
 				}
-			// 회원이 없는 경우
+			// 매장이 없는 경우
 			case repository.DB_FK_ERROR:
 				switch true {
-				case strings.Contains(mysqlErr.Message, "user_id"):
-					cErr = errNotFoundUser
+				case strings.Contains(mysqlErr.Message, "store_id"):
+					cErr = errNotFoundStore
 					return
 				}
 			}
@@ -69,28 +85,110 @@ func (service *service) CreateProduct(ctx context.Context, params CreateProductP
 
 type GetProductListParams struct {
 	UserID int64
+	dto.StoreRequestHeader
 	dto.GetProductListRequestQuery
 }
 
 // 상품 목록 조회 로직
 func (service *service) GetProductList(ctx context.Context, params GetProductListParams) (result dto.GetProductListResponse, cErr CustomErr) {
-	arg := repository.GetProductListParams{
-		UserID:        params.UserID,
-		Searchchosung: params.Keyword,
-		Offset:        10 * (params.Page - 1),
+	// 매장 회원 확인
+	member, cErr := service.getStoreMember(ctx, params.UserID, params.StoreID)
+	if cErr.Err != nil {
+		return
 	}
 
-	// 상품 검색
-	productList, err := service.repository.GetProductList(ctx, arg)
+	// 조회 개수 검증
+	size := params.Size
+	if size == 0 {
+		size = productListDefaultSize
+	}
+	maxSize := service.config.PageMaxSize
+	if maxSize <= 0 || maxSize > productListMaxSize {
+		maxSize = productListMaxSize
+	}
+	if size > maxSize {
+		cErr = errPageSizeTooLarge(maxSize)
+		return
+	}
+
+	// 검색 조건 변환
+	filter, cErr := newProductFilter(params.GetProductListRequestQuery, time.Now())
+	if cErr.Err != nil {
+		return
+	}
+
+	// 상품 검색 (다음 목록 존재 여부 확인을 위해 1개 더 조회)
+	var productList []repository.Product
+	if params.Cursor != "" {
+		productList, cErr = service.getProductListByCursor(ctx, member.StoreID, filter, params.Cursor, size)
+	} else {
+		productList, cErr = service.getProductListByPage(ctx, member.StoreID, filter, params.Page, size)
+	}
+	if cErr.Err != nil {
+		return
+	}
+
+	hasNext := len(productList) > int(size)
+	if hasNext {
+		productList = productList[:size]
+	}
+
+	// 전체 상품 개수 (목록 조회와 같은 검색 조건)
+	totalCount, err := service.repository.GetProductCount(ctx, filter.countParams(member.StoreID))
 	if err != nil {
 		cErr = NewErrInternalServer(err)
 		return
 	}
 
 	result = dto.NewGetProductListResponse(productList)
+	result.TotalCount = totalCount
+	result.TotalPages = (totalCount + int64(size) - 1) / int64(size)
+	result.HasNext = hasNext
+	// 마지막 상품 기준으로 다음 커서 생성
+	if hasNext {
+		lastProduct := productList[len(productList)-1]
+		result.NextCursor = service.cursorSigner.Encode(cursor.Cursor{CreatedAt: lastProduct.CreatedAt, ID: lastProduct.ID})
+	}
+
 	return
 }
 
+// 페이지 번호 기반 상품 목록 조회
+func (service *service) getProductListByPage(ctx context.Context, storeID int64, filter productFilter, page int32, size int32) ([]repository.Product, CustomErr) {
+	// int32 범위를 넘지 않도록 int64로 계산 후 검증
+	offset := int64(size) * (int64(page) - 1)
+	if offset > math.MaxInt32 {
+		return nil, errPageOutOfRange
+	}
+
+	arg := filter.listParams(storeID, size+1, int32(offset))
+
+	productList, err := service.repository.GetProductList(ctx, arg)
+	if err != nil {
+		return nil, NewErrInternalServer(err)
+	}
+
+	return productList, CustomErr{}
+}
+
+// 커서((created_at, id)) 기반 상품 목록 조회
+// 조회 중 상품이 추가, 삭제되어도 누락, 중복 없이 이어서 조회
+func (service *service) getProductListByCursor(ctx context.Context, storeID int64, filter productFilter, value string, size int32) ([]repository.Product, CustomErr) {
+	productCursor, err := service.cursorSigner.Decode(value)
+	if err != nil {
+		return nil, NewErrBadRequest(err)
+	}
+
+	arg := filter.cursorParams(storeID, productCursor, size+1)
+
+	productList, err := service.repository.GetProductListByCursor(ctx, arg)
+	if err != nil {
+		return nil, NewErrInternalServer(err)
+	}
+
+	return productList, CustomErr{}
+}
+
 type GetProductParams struct {
 	UserID int64
 	dto.GetProductRequestPath
@@ -111,9 +209,9 @@ func (service *service) GetProduct(ctx context.Context, params GetProductParams)
 		return
 	}
 
-	// 상품 등록 회원 확인
-	if product.UserID != params.UserID {
-		cErr = errForbiddenProduct
+	// 상품 매장 회원 확인
+	cErr = service.checkProductStoreMember(ctx, product, params.UserID)
+	if cErr.Err != nil {
 		return
 	}
 
@@ -142,9 +240,9 @@ func (service *service) UpdateProduct(ctx context.Context, params UpdateProductP
 		return
 	}
 
-	// 상품 등록 회원 확인
-	if product.UserID != params.UserID {
-		cErr = errForbiddenProduct
+	// 상품 매장 점주 확인
+	cErr = service.checkProductStoreMember(ctx, product, params.UserID, repository.StoreMemberRoleOwner)
+	if cErr.Err != nil {
 		return
 	}
 
@@ -232,9 +330,9 @@ func (service *service) DeleteProduct(ctx context.Context, params DeleteProductP
 		return
 	}
 
-	// 상품 등록 회원 확인
-	if product.UserID != params.UserID {
-		cErr = errForbiddenProduct
+	// 상품 매장 점주 확인
+	cErr = service.checkProductStoreMember(ctx, product, params.UserID, repository.StoreMemberRoleOwner)
+	if cErr.Err != nil {
 		return
 	}
 
@@ -247,3 +345,29 @@ func (service *service) DeleteProduct(ctx context.Context, params DeleteProductP
 
 	return
 }
+
+// 상품이 속한 매장의 회원인지 확인하는 함수
+// roles 입력 시 매장 역할까지 확인
+func (service *service) checkProductStoreMember(ctx context.Context, product repository.Product, userID int64, roles ...repository.StoreMemberRole) (cErr CustomErr) {
+	member, err := service.repository.GetStoreMember(ctx, repository.GetStoreMemberParams{
+		StoreID: product.StoreID,
+		UserID:  userID,
+	})
+	if err != nil {
+		// 매장 회원이 아닌 경우
+		if err == sql.ErrNoRows {
+			cErr = errForbiddenProduct
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	if !hasStoreMemberRole(member, roles) {
+		cErr = errForbiddenStoreRole
+		return
+	}
+
+	return
+}
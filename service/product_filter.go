@@ -0,0 +1,172 @@
+package service
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
+)
+
+// 상품 목록 검색 조건 (목록, 개수 조회 공통)
+// Valid가 false인 조건은 쿼리에서 적용하지 않음
+type productFilter struct {
+	keyword            string
+	category           sql.NullString
+	size               repository.NullProductSize
+	minPrice           sql.NullInt32
+	maxPrice           sql.NullInt32
+	minCost            sql.NullInt32
+	maxCost            sql.NullInt32
+	expirationDateFrom sql.NullTime
+	expirationDateTo   sql.NullTime
+	createdAtFrom      sql.NullTime
+	createdAtTo        sql.NullTime
+}
+
+// 요청 조건을 검색 조건으로 변환
+// now는 expiring_within_days의 기준일
+func newProductFilter(query dto.GetProductListRequestQuery, now time.Time) (filter productFilter, cErr CustomErr) {
+	// 범위 검증
+	if query.MinPrice != nil && query.MaxPrice != nil && *query.MinPrice > *query.MaxPrice {
+		cErr = errInvalidRange("min_price", "max_price")
+		return
+	}
+	if query.MinCost != nil && query.MaxCost != nil && *query.MinCost > *query.MaxCost {
+		cErr = errInvalidRange("min_cost", "max_cost")
+		return
+	}
+
+	filter = productFilter{
+		keyword:  query.Keyword,
+		category: sql.NullString{String: query.Category, Valid: query.Category != ""},
+		size:     repository.NullProductSize{ProductSize: repository.ProductSize(query.ProductSize), Valid: query.ProductSize != ""},
+	}
+	if query.MinPrice != nil {
+		filter.minPrice = sql.NullInt32{Int32: *query.MinPrice, Valid: true}
+	}
+	if query.MaxPrice != nil {
+		filter.maxPrice = sql.NullInt32{Int32: *query.MaxPrice, Valid: true}
+	}
+	if query.MinCost != nil {
+		filter.minCost = sql.NullInt32{Int32: *query.MinCost, Valid: true}
+	}
+	if query.MaxCost != nil {
+		filter.maxCost = sql.NullInt32{Int32: *query.MaxCost, Valid: true}
+	}
+
+	// string 타입의 날짜 time 타입으로 변환
+	if filter.expirationDateFrom, cErr = parseNullDate(query.ExpirationDateFrom); cErr.Err != nil {
+		return
+	}
+	if filter.expirationDateTo, cErr = parseNullDate(query.ExpirationDateTo); cErr.Err != nil {
+		return
+	}
+	if filter.createdAtFrom, cErr = parseNullDate(query.CreatedDateFrom); cErr.Err != nil {
+		return
+	}
+	if filter.createdAtTo, cErr = parseNullDate(query.CreatedDateTo); cErr.Err != nil {
+		return
+	}
+
+	if filter.expirationDateFrom.Valid && filter.expirationDateTo.Valid && filter.expirationDateFrom.Time.After(filter.expirationDateTo.Time) {
+		cErr = errInvalidRange("expiration_date_from", "expiration_date_to")
+		return
+	}
+	if filter.createdAtFrom.Valid && filter.createdAtTo.Valid && filter.createdAtFrom.Time.After(filter.createdAtTo.Time) {
+		cErr = errInvalidRange("created_date_from", "created_date_to")
+		return
+	}
+
+	// 오늘부터 n일 이내 만료 조건을 유통기한 범위와 합침
+	if query.ExpiringWithinDays != nil {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		until := today.AddDate(0, 0, int(*query.ExpiringWithinDays))
+
+		if !filter.expirationDateFrom.Valid || filter.expirationDateFrom.Time.Before(today) {
+			filter.expirationDateFrom = sql.NullTime{Time: today, Valid: true}
+		}
+		if !filter.expirationDateTo.Valid || filter.expirationDateTo.Time.After(until) {
+			filter.expirationDateTo = sql.NullTime{Time: until, Valid: true}
+		}
+	}
+
+	// 등록일 종료일은 해당 날짜 전체 포함
+	if filter.createdAtTo.Valid {
+		filter.createdAtTo.Time = filter.createdAtTo.Time.AddDate(0, 0, 1)
+	}
+
+	return
+}
+
+// 빈 문자열이면 조건 없음
+func parseNullDate(value string) (sql.NullTime, CustomErr) {
+	if value == "" {
+		return sql.NullTime{}, CustomErr{}
+	}
+
+	parsedTime, err := time.Parse(util.DateLayout, value)
+	if err != nil {
+		return sql.NullTime{}, errParseDate
+	}
+
+	return sql.NullTime{Time: parsedTime, Valid: true}, CustomErr{}
+}
+
+func (filter productFilter) listParams(storeID int64, limit int32, offset int32) repository.GetProductListParams {
+	return repository.GetProductListParams{
+		StoreID:            storeID,
+		Searchchosung:      filter.keyword,
+		Category:           filter.category,
+		Size:               filter.size,
+		MinPrice:           filter.minPrice,
+		MaxPrice:           filter.maxPrice,
+		MinCost:            filter.minCost,
+		MaxCost:            filter.maxCost,
+		ExpirationDateFrom: filter.expirationDateFrom,
+		ExpirationDateTo:   filter.expirationDateTo,
+		CreatedAtFrom:      filter.createdAtFrom,
+		CreatedAtTo:        filter.createdAtTo,
+		Limit:              limit,
+		Offset:             offset,
+	}
+}
+
+func (filter productFilter) cursorParams(storeID int64, productCursor cursor.Cursor, limit int32) repository.GetProductListByCursorParams {
+	return repository.GetProductListByCursorParams{
+		StoreID:            storeID,
+		Searchchosung:      filter.keyword,
+		Category:           filter.category,
+		Size:               filter.size,
+		MinPrice:           filter.minPrice,
+		MaxPrice:           filter.maxPrice,
+		MinCost:            filter.minCost,
+		MaxCost:            filter.maxCost,
+		ExpirationDateFrom: filter.expirationDateFrom,
+		ExpirationDateTo:   filter.expirationDateTo,
+		CreatedAtFrom:      filter.createdAtFrom,
+		CreatedAtTo:        filter.createdAtTo,
+		CursorCreatedAt:    productCursor.CreatedAt,
+		CursorID:           productCursor.ID,
+		Limit:              limit,
+	}
+}
+
+func (filter productFilter) countParams(storeID int64) repository.GetProductCountParams {
+	return repository.GetProductCountParams{
+		StoreID:            storeID,
+		Searchchosung:      filter.keyword,
+		Category:           filter.category,
+		Size:               filter.size,
+		MinPrice:           filter.minPrice,
+		MaxPrice:           filter.maxPrice,
+		MinCost:            filter.minCost,
+		MaxCost:            filter.maxCost,
+		ExpirationDateFrom: filter.expirationDateFrom,
+		ExpirationDateTo:   filter.expirationDateTo,
+		CreatedAtFrom:      filter.createdAtFrom,
+		CreatedAtTo:        filter.createdAtTo,
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProductFilter(t *testing.T) {
+	now := time.Date(2023, 1, 2, 15, 4, 5, 0, time.Local)
+	today := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	price, cost := int32(1000), int32(500)
+	zeroDays, sevenDays := int32(0), int32(7)
+
+	testCases := []struct {
+		name        string
+		query       dto.GetProductListRequestQuery
+		checkFilter func(filter productFilter, err CustomErr)
+	}{
+		{
+			name:  "조건 없음",
+			query: dto.GetProductListRequestQuery{Keyword: "ㅇㄹ"},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, productFilter{keyword: "ㅇㄹ"}, filter)
+			},
+		},
+		{
+			name: "전체 조건",
+			query: dto.GetProductListRequestQuery{
+				Category:           "음료",
+				ProductSize:        string(repository.ProductSizeLarge),
+				MinPrice:           &cost,
+				MaxPrice:           &price,
+				MinCost:            &cost,
+				MaxCost:            &price,
+				ExpirationDateFrom: "2023-01-01",
+				ExpirationDateTo:   "2023-01-31",
+				CreatedDateFrom:    "2022-12-01",
+				CreatedDateTo:      "2022-12-31",
+			},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, sql.NullString{String: "음료", Valid: true}, filter.category)
+				require.Equal(t, repository.NullProductSize{ProductSize: repository.ProductSizeLarge, Valid: true}, filter.size)
+				require.Equal(t, sql.NullInt32{Int32: cost, Valid: true}, filter.minPrice)
+				require.Equal(t, sql.NullInt32{Int32: price, Valid: true}, filter.maxPrice)
+				require.Equal(t, sql.NullInt32{Int32: cost, Valid: true}, filter.minCost)
+				require.Equal(t, sql.NullInt32{Int32: price, Valid: true}, filter.maxCost)
+				require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), filter.expirationDateFrom.Time)
+				require.Equal(t, time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), filter.expirationDateTo.Time)
+				require.Equal(t, time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), filter.createdAtFrom.Time)
+				// 종료일 당일 포함
+				require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), filter.createdAtTo.Time)
+			},
+		},
+		{
+			name:  "n일 이내 만료",
+			query: dto.GetProductListRequestQuery{ExpiringWithinDays: &sevenDays},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, sql.NullTime{Time: today, Valid: true}, filter.expirationDateFrom)
+				require.Equal(t, sql.NullTime{Time: today.AddDate(0, 0, 7), Valid: true}, filter.expirationDateTo)
+			},
+		},
+		{
+			name:  "오늘 만료",
+			query: dto.GetProductListRequestQuery{ExpiringWithinDays: &zeroDays},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, sql.NullTime{Time: today, Valid: true}, filter.expirationDateFrom)
+				require.Equal(t, sql.NullTime{Time: today, Valid: true}, filter.expirationDateTo)
+			},
+		},
+		{
+			name: "n일 이내 만료와 유통기한 범위 교집합",
+			query: dto.GetProductListRequestQuery{
+				ExpirationDateFrom: "2023-01-05",
+				ExpirationDateTo:   "2023-01-31",
+				ExpiringWithinDays: &sevenDays,
+			},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), filter.expirationDateFrom.Time)
+				require.Equal(t, today.AddDate(0, 0, 7), filter.expirationDateTo.Time)
+			},
+		},
+		{
+			name:  "가격 범위 오류",
+			query: dto.GetProductListRequestQuery{MinPrice: &price, MaxPrice: &cost},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Equal(t, errInvalidRange("min_price", "max_price"), err)
+			},
+		},
+		{
+			name:  "원가 범위 오류",
+			query: dto.GetProductListRequestQuery{MinCost: &price, MaxCost: &cost},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Equal(t, errInvalidRange("min_cost", "max_cost"), err)
+			},
+		},
+		{
+			name:  "유통기한 범위 오류",
+			query: dto.GetProductListRequestQuery{ExpirationDateFrom: "2023-01-31", ExpirationDateTo: "2023-01-01"},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Equal(t, errInvalidRange("expiration_date_from", "expiration_date_to"), err)
+			},
+		},
+		{
+			name:  "등록일 범위 오류",
+			query: dto.GetProductListRequestQuery{CreatedDateFrom: "2023-01-31", CreatedDateTo: "2023-01-01"},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Equal(t, errInvalidRange("created_date_from", "created_date_to"), err)
+			},
+		},
+		{
+			name:  "날짜 변환 오류",
+			query: dto.GetProductListRequestQuery{ExpirationDateFrom: "2023-13-01"},
+			checkFilter: func(filter productFilter, err CustomErr) {
+				require.Equal(t, errParseDate, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := newProductFilter(tc.query, now)
+			tc.checkFilter(filter, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"math"
 	"testing"
 	"time"
 
@@ -10,6 +11,11 @@ import (
 	"github.com/gitaepark/pha/repository"
 	mockrepository "github.com/gitaepark/pha/repository/mock"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
 	"github.com/go-sql-driver/mysql"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -17,7 +23,10 @@ import (
 
 func TestCreateProduct(t *testing.T) {
 	user, _ := createRandomUser(t)
-	product := createRandomProduct(t, user)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleOwner)
+	staffMember := member
+	staffMember.Role = repository.StoreMemberRoleStaff
+	product := createRandomProduct(t, member)
 
 	testCases := []struct {
 		name          string
@@ -41,6 +50,10 @@ func TestCreateProduct(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					CreateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -66,6 +79,10 @@ func TestCreateProduct(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					CreateProduct(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -90,6 +107,10 @@ func TestCreateProduct(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					CreateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -100,9 +121,76 @@ func TestCreateProduct(t *testing.T) {
 			},
 		},
 		{
-			name: "회원이 없는 경우",
+			name: "다른 매장 지정 성공",
 			params: CreateProductParams{
-				UserID: util.CreateRandomInt64(11, 20),
+				UserID:             user.ID,
+				StoreRequestHeader: dto.StoreRequestHeader{StoreID: member.StoreID},
+				CreateProductRequestBody: dto.CreateProductRequestBody{
+					Category:       product.Category,
+					Price:          product.Price,
+					Cost:           product.Cost,
+					Name:           product.Name,
+					Description:    product.Description,
+					Barcode:        product.Barcode,
+					ExpirationDate: product.ExpirationDate.Format(util.DateLayout),
+					Size:           string(product.Size),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: member.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				mockRepository.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.CreateProductParams) error {
+						require.Equal(t, arg.StoreID, member.StoreID)
+						return nil
+					})
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 경우",
+			params: CreateProductParams{
+				UserID:             user.ID,
+				StoreRequestHeader: dto.StoreRequestHeader{StoreID: util.CreateRandomInt64(11, 20)},
+				CreateProductRequestBody: dto.CreateProductRequestBody{
+					Category:       product.Category,
+					Price:          product.Price,
+					Cost:           product.Cost,
+					Name:           product.Name,
+					Description:    product.Description,
+					Barcode:        product.Barcode,
+					ExpirationDate: product.ExpirationDate.Format(util.DateLayout),
+					Size:           string(product.Size),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+
+				mockRepository.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStore)
+			},
+		},
+		{
+			name: "매장 직원인 경우",
+			params: CreateProductParams{
+				UserID: user.ID,
 				CreateProductRequestBody: dto.CreateProductRequestBody{
 					Category:       product.Category,
 					Price:          product.Price,
@@ -115,13 +203,76 @@ func TestCreateProduct(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+
 				mockRepository.EXPECT().
 					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreRole)
+			},
+		},
+		{
+			name: "소속 매장이 없는 경우",
+			params: CreateProductParams{
+				UserID: user.ID,
+				CreateProductRequestBody: dto.CreateProductRequestBody{
+					Category:       product.Category,
+					Price:          product.Price,
+					Cost:           product.Cost,
+					Name:           product.Name,
+					Description:    product.Description,
+					Barcode:        product.Barcode,
+					ExpirationDate: product.ExpirationDate.Format(util.DateLayout),
+					Size:           string(product.Size),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(&mysql.MySQLError{Number: repository.DB_FK_ERROR, Message: "user_id"})
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+
+				mockRepository.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(err CustomErr) {
-				require.Equal(t, err, errNotFoundUser)
+				require.Equal(t, err, errNotFoundStore)
+			},
+		},
+		{
+			name: "매장이 없는 경우",
+			params: CreateProductParams{
+				UserID: user.ID,
+				CreateProductRequestBody: dto.CreateProductRequestBody{
+					Category:       product.Category,
+					Price:          product.Price,
+					Cost:           product.Cost,
+					Name:           product.Name,
+					Description:    product.Description,
+					Barcode:        product.Barcode,
+					ExpirationDate: product.ExpirationDate.Format(util.DateLayout),
+					Size:           string(product.Size),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(member, nil)
+
+				mockRepository.EXPECT().
+					CreateProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&mysql.MySQLError{Number: repository.DB_FK_ERROR, Message: "store_id"})
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundStore)
 			},
 		},
 		{
@@ -140,6 +291,10 @@ func TestCreateProduct(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					CreateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -171,10 +326,16 @@ func TestCreateProduct(t *testing.T) {
 
 func TestGetProductList(t *testing.T) {
 	user, _ := createRandomUser(t)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleStaff)
+	// 기본 조회 개수보다 1개 더 생성 (다음 목록 존재)
 	var productList []repository.Product
-	for i := 0; i < 10; i++ {
-		productList = append(productList, createRandomProduct(t, user))
+	for i := 0; i < productListDefaultSize+1; i++ {
+		productList = append(productList, createRandomProduct(t, member))
 	}
+	signer := testCursorSigner
+	lastProduct := productList[productListDefaultSize-1]
+	// 최소 가격이 최대 가격보다 큰 범위
+	invalidMinPrice, invalidMaxPrice := int32(2000), int32(1000)
 
 	testCases := []struct {
 		name          string
@@ -191,14 +352,39 @@ func TestGetProductList(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.GetProductListParams{
+					StoreID:       member.StoreID,
+					Searchchosung: "",
+					Limit:         productListDefaultSize + 1,
+					Offset:        0,
+				}
+
 				mockRepository.EXPECT().
-					GetProductList(gomock.Any(), gomock.Any()).
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(productList, nil)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Eq(repository.GetProductCountParams{StoreID: member.StoreID, Searchchosung: ""})).
+					Times(1).
+					Return(int64(25), nil)
 			},
 			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
 				require.NotEmpty(t, result)
 				require.Empty(t, err)
+				require.Len(t, result.List, productListDefaultSize)
+				require.Equal(t, int64(25), result.TotalCount)
+				require.Equal(t, int64(3), result.TotalPages)
+				require.True(t, result.HasNext)
+				require.NotEmpty(t, result.NextCursor)
+
+				nextCursor, decodeErr := signer.Decode(result.NextCursor)
+				require.NoError(t, decodeErr)
+				require.Equal(t, lastProduct.ID, nextCursor.ID)
+				require.True(t, lastProduct.CreatedAt.Equal(nextCursor.CreatedAt))
 
 				for idx, product := range result.List {
 					require.Equal(t, product.ID, productList[idx].ID)
@@ -215,6 +401,71 @@ func TestGetProductList(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "조회 개수 지정",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page: 3,
+					Size: 4,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.GetProductListParams{
+					StoreID:       member.StoreID,
+					Searchchosung: "",
+					Limit:         5,
+					Offset:        8,
+				}
+
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(productList[8:], nil)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(len(productList)), nil)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, 3)
+				require.Equal(t, int64(len(productList)), result.TotalCount)
+				require.Equal(t, int64(3), result.TotalPages)
+				require.False(t, result.HasNext)
+				require.Empty(t, result.NextCursor)
+			},
+		},
+		{
+			name: "최대 조회 개수 초과",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page: 1,
+					Size: testConfig.PageMaxSize + 1,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errPageSizeTooLarge(testConfig.PageMaxSize))
+			},
+		},
 		{
 			name: "검색 성공",
 			params: GetProductListParams{
@@ -225,14 +476,26 @@ func TestGetProductList(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					GetProductList(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(productList[0:1], nil)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Eq(repository.GetProductCountParams{StoreID: member.StoreID, Searchchosung: productList[0].Name})).
+					Times(1).
+					Return(int64(1), nil)
 			},
 			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
 				require.NotEmpty(t, result)
 				require.Empty(t, err)
+				require.Equal(t, int64(1), result.TotalCount)
+				require.Equal(t, int64(1), result.TotalPages)
+				require.False(t, result.HasNext)
+				require.Empty(t, result.NextCursor)
 
 				for _, product := range result.List {
 					require.Equal(t, productList[0].ID, product.ID)
@@ -249,6 +512,176 @@ func TestGetProductList(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "필터 적용 성공",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page:               1,
+					Category:           productList[0].Category,
+					ProductSize:        string(productList[0].Size),
+					MinPrice:           &productList[0].Price,
+					ExpirationDateFrom: "2023-01-01",
+					ExpirationDateTo:   "2023-01-07",
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				expirationDateFrom, _ := time.Parse(util.DateLayout, "2023-01-01")
+				expirationDateTo, _ := time.Parse(util.DateLayout, "2023-01-07")
+				arg := repository.GetProductListParams{
+					StoreID:            member.StoreID,
+					Searchchosung:      "",
+					Category:           sql.NullString{String: productList[0].Category, Valid: true},
+					Size:               repository.NullProductSize{ProductSize: productList[0].Size, Valid: true},
+					MinPrice:           sql.NullInt32{Int32: productList[0].Price, Valid: true},
+					ExpirationDateFrom: sql.NullTime{Time: expirationDateFrom, Valid: true},
+					ExpirationDateTo:   sql.NullTime{Time: expirationDateTo, Valid: true},
+					Limit:              productListDefaultSize + 1,
+					Offset:             0,
+				}
+				countArg := repository.GetProductCountParams{
+					StoreID:            arg.StoreID,
+					Searchchosung:      arg.Searchchosung,
+					Category:           arg.Category,
+					Size:               arg.Size,
+					MinPrice:           arg.MinPrice,
+					ExpirationDateFrom: arg.ExpirationDateFrom,
+					ExpirationDateTo:   arg.ExpirationDateTo,
+				}
+
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(productList[0:1], nil)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Eq(countArg)).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, 1)
+				require.Equal(t, productList[0].ID, result.List[0].ID)
+				require.Equal(t, int64(1), result.TotalCount)
+				require.False(t, result.HasNext)
+			},
+		},
+		{
+			name: "가격 범위 오류",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page:     1,
+					MinPrice: &invalidMinPrice,
+					MaxPrice: &invalidMaxPrice,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errInvalidRange("min_price", "max_price"))
+			},
+		},
+		{
+			name: "커서 기반 조회 성공",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Cursor: signer.Encode(cursor.Cursor{CreatedAt: lastProduct.CreatedAt, ID: lastProduct.ID}),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetProductListByCursor(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg repository.GetProductListByCursorParams) ([]repository.Product, error) {
+						require.Equal(t, member.StoreID, arg.StoreID)
+						require.Equal(t, lastProduct.ID, arg.CursorID)
+						require.True(t, lastProduct.CreatedAt.Equal(arg.CursorCreatedAt))
+						require.Equal(t, int32(productListDefaultSize+1), arg.Limit)
+						return productList[productListDefaultSize:], nil
+					})
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(len(productList)), nil)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, 1)
+				require.Equal(t, int64(len(productList)), result.TotalCount)
+				require.Equal(t, int64(2), result.TotalPages)
+				require.False(t, result.HasNext)
+				require.Empty(t, result.NextCursor)
+			},
+		},
+		{
+			name: "유효하지 않은 커서",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Cursor: util.CreateRandomString(20) + "." + util.CreateRandomString(20),
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductListByCursor(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrBadRequest(cursor.ErrInvalidCursor))
+			},
+		},
+		{
+			name: "매장 회원이 아닌 경우",
+			params: GetProductListParams{
+				UserID:             user.ID,
+				StoreRequestHeader: dto.StoreRequestHeader{StoreID: util.CreateRandomInt64(11, 20)},
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page: 1,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errForbiddenStore)
+			},
+		},
 		{
 			name: "Internal Server Error",
 			params: GetProductListParams{
@@ -258,6 +691,10 @@ func TestGetProductList(t *testing.T) {
 				},
 			},
 			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
 				mockRepository.EXPECT().
 					GetProductList(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -268,6 +705,33 @@ func TestGetProductList(t *testing.T) {
 				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
 			},
 		},
+		{
+			name: "상품 개수 조회 Internal Server Error",
+			params: GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page: 1,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(productList, nil)
+				mockRepository.EXPECT().
+					GetProductCount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetProductListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -288,9 +752,127 @@ func TestGetProductList(t *testing.T) {
 	}
 }
 
+func TestGetProductListMaxSize(t *testing.T) {
+	user, _ := createRandomUser(t)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleStaff)
+
+	testCases := []struct {
+		name        string
+		pageMaxSize int32
+	}{
+		{
+			name:        "PAGE_MAX_SIZE 미설정",
+			pageMaxSize: 0,
+		},
+		{
+			name:        "PAGE_MAX_SIZE가 최대 조회 개수보다 큰 경우",
+			pageMaxSize: productListMaxSize * 10,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config := testConfig
+			config.PageMaxSize = tc.pageMaxSize
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, repository)
+
+			repository.EXPECT().
+				GetDefaultStoreMember(gomock.Any(), gomock.Eq(user.ID)).
+				Times(1).
+				Return(member, nil)
+			repository.EXPECT().
+				GetProductList(gomock.Any(), gomock.Any()).
+				Times(0)
+
+			params := GetProductListParams{
+				UserID: user.ID,
+				GetProductListRequestQuery: dto.GetProductListRequestQuery{
+					Page: 1,
+					Size: productListMaxSize + 1,
+				},
+			}
+
+			result, err := service.GetProductList(context.Background(), params)
+			require.Empty(t, result)
+			require.Equal(t, err, errPageSizeTooLarge(productListMaxSize))
+		})
+	}
+}
+
+func TestGetProductListByPage(t *testing.T) {
+	storeID := util.CreateRandomInt64(1, 10)
+
+	testCases := []struct {
+		name          string
+		page          int32
+		size          int32
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			page: 10000,
+			size: productListMaxSize,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.GetProductListParams) ([]repository.Product, error) {
+						require.Equal(t, int32(productListMaxSize+1), arg.Limit)
+						require.Equal(t, int32(9999*productListMaxSize), arg.Offset)
+						return []repository.Product{}, nil
+					})
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "offset이 int32 범위를 넘는 경우",
+			page: math.MaxInt32,
+			size: productListMaxSize,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetProductList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errPageOutOfRange)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository).(*service)
+
+			tc.buildStubs(repository)
+
+			_, err := service.getProductListByPage(context.Background(), storeID, productFilter{}, tc.page, tc.size)
+			tc.checkResponse(err)
+		})
+	}
+}
+
 func TestGetProduct(t *testing.T) {
 	user, _ := createRandomUser(t)
-	product := createRandomProduct(t, user)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleOwner)
+	staffMember := member
+	staffMember.Role = repository.StoreMemberRoleStaff
+	product := createRandomProduct(t, member)
 
 	testCases := []struct {
 		name          string
@@ -311,6 +893,11 @@ func TestGetProduct(t *testing.T) {
 					GetProduct(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(product, nil)
+
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
 			},
 			checkResponse: func(result dto.GetProductResponse, err CustomErr) {
 				require.NotEmpty(t, result)
@@ -329,6 +916,31 @@ func TestGetProduct(t *testing.T) {
 				require.WithinDuration(t, result.UpdatedAt, product.UpdatedAt, time.Second)
 			},
 		},
+		{
+			name: "매장 직원 조회 성공",
+			params: GetProductParams{
+				UserID: user.ID,
+				GetProductRequestPath: dto.GetProductRequestPath{
+					ID: product.ID,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(product, nil)
+
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+			},
+			checkResponse: func(result dto.GetProductResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, result.ID, product.ID)
+				require.Equal(t, result.StoreID, product.StoreID)
+			},
+		},
 		{
 			name: "상품이 없는 경우",
 			params: GetProductParams{
@@ -349,7 +961,7 @@ func TestGetProduct(t *testing.T) {
 			},
 		},
 		{
-			name: "상품을 등록한 회원이 아닌 경우",
+			name: "매장 회원이 아닌 경우",
 			params: GetProductParams{
 				UserID: util.CreateRandomInt64(11, 20),
 				GetProductRequestPath: dto.GetProductRequestPath{
@@ -362,6 +974,11 @@ func TestGetProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+
 				mockRepository.EXPECT().
 					DeleteProduct(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -411,11 +1028,14 @@ func TestGetProduct(t *testing.T) {
 
 func TestUpdateProduct(t *testing.T) {
 	user, _ := createRandomUser(t)
-	product := createRandomProduct(t, user)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleOwner)
+	staffMember := member
+	staffMember.Role = repository.StoreMemberRoleStaff
+	product := createRandomProduct(t, member)
 
 	updatedProduct := repository.Product{
 		ID:             product.ID,
-		UserID:         user.ID,
+		StoreID:        member.StoreID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
@@ -453,6 +1073,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -479,6 +1104,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -505,6 +1135,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -531,6 +1166,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -557,6 +1197,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -583,6 +1228,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -609,6 +1259,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -635,6 +1290,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -670,7 +1330,7 @@ func TestUpdateProduct(t *testing.T) {
 			},
 		},
 		{
-			name: "상품을 등록한 회원이 아닌 경우",
+			name: "매장 회원이 아닌 경우",
 			params: UpdateProductParams{
 				UserID: util.CreateRandomInt64(11, 20),
 				UpdateProductRequestPath: dto.UpdateProductRequestPath{
@@ -686,6 +1346,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -694,6 +1359,36 @@ func TestUpdateProduct(t *testing.T) {
 				require.Equal(t, err, errForbiddenProduct)
 			},
 		},
+		{
+			name: "매장 직원인 경우",
+			params: UpdateProductParams{
+				UserID: user.ID,
+				UpdateProductRequestPath: dto.UpdateProductRequestPath{
+					ID: product.ID,
+				},
+				UpdateProductRequestBody: dto.UpdateProductRequestBody{
+					Category: &updatedProduct.Category,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(product, nil)
+
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+
+				mockRepository.EXPECT().
+					UpdateProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreRole)
+			},
+		},
 		{
 			name: "날짜 변환 실패",
 			params: UpdateProductParams{
@@ -711,6 +1406,11 @@ func TestUpdateProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					UpdateProduct(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -766,7 +1466,10 @@ func TestUpdateProduct(t *testing.T) {
 
 func TestDeleteProduct(t *testing.T) {
 	user, _ := createRandomUser(t)
-	product := createRandomProduct(t, user)
+	member := createRandomStoreMember(t, user, repository.StoreMemberRoleOwner)
+	staffMember := member
+	staffMember.Role = repository.StoreMemberRoleStaff
+	product := createRandomProduct(t, member)
 
 	testCases := []struct {
 		name          string
@@ -788,6 +1491,11 @@ func TestDeleteProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: product.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(member, nil)
+
 				mockRepository.EXPECT().
 					DeleteProduct(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -820,7 +1528,7 @@ func TestDeleteProduct(t *testing.T) {
 			},
 		},
 		{
-			name: "상품을 등록한 회원이 아닌 경우",
+			name: "매장 회원이 아닌 경우",
 			params: DeleteProductParams{
 				UserID: util.CreateRandomInt64(11, 20),
 				DeleteProductRequestPath: dto.DeleteProductRequestPath{
@@ -833,6 +1541,11 @@ func TestDeleteProduct(t *testing.T) {
 					Times(1).
 					Return(product, nil)
 
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+
 				mockRepository.EXPECT().
 					DeleteProduct(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -841,6 +1554,33 @@ func TestDeleteProduct(t *testing.T) {
 				require.Equal(t, err, errForbiddenProduct)
 			},
 		},
+		{
+			name: "매장 직원인 경우",
+			params: DeleteProductParams{
+				UserID: user.ID,
+				DeleteProductRequestPath: dto.DeleteProductRequestPath{
+					ID: product.ID,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetProduct(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(product, nil)
+
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+
+				mockRepository.EXPECT().
+					DeleteProduct(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreRole)
+			},
+		},
 		{
 			name: "Internal Server Error",
 			params: DeleteProductParams{
@@ -879,10 +1619,10 @@ func TestDeleteProduct(t *testing.T) {
 	}
 }
 
-func createRandomProduct(t *testing.T, user repository.User) repository.Product {
+func createRandomProduct(t *testing.T, member repository.StoreMember) repository.Product {
 	product := repository.Product{
 		ID:             util.CreateRandomInt64(1, 10),
-		UserID:         user.ID,
+		StoreID:        member.StoreID,
 		Category:       util.CreateRandomString(15),
 		Price:          util.CreateRandomInt32(1000, 10000),
 		Cost:           util.CreateRandomInt32(1000, 10000),
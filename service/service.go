@@ -6,6 +6,14 @@ import (
 	"github.com/gitaepark/pha/dto"
 	"github.com/gitaepark/pha/repository"
 	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/cursor"
+	"github.com/gitaepark/pha/util/hasher"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/gitaepark/pha/util/validator"
 )
 
 type Service interface {
@@ -13,6 +21,46 @@ type Service interface {
 	Register(ctx context.Context, params RegisterParams) (cErr CustomErr)
 	Login(ctx context.Context, params LoginParams) (result dto.LoginResponseBody, cErr CustomErr)
 	RenewAccessToken(ctx context.Context, params RenewAccessTokenParams) (result dto.RenewAccessTokenResponse, cErr CustomErr)
+	Logout(ctx context.Context, params LogoutParams) (cErr CustomErr)
+	ChangePassword(ctx context.Context, params ChangePasswordParams) (cErr CustomErr)
+	SendVerificationCode(ctx context.Context, params SendVerificationCodeParams) (cErr CustomErr)
+	ResetPassword(ctx context.Context, params ResetPasswordParams) (cErr CustomErr)
+	IntrospectToken(ctx context.Context, params IntrospectTokenParams) (result dto.IntrospectTokenResponse, cErr CustomErr)
+
+	// auth event
+	GetAuthEventList(ctx context.Context, params GetAuthEventListParams) (result dto.GetAuthEventListResponse, cErr CustomErr)
+
+	// two factor
+	EnrollTwoFactor(ctx context.Context, params EnrollTwoFactorParams) (result dto.EnrollTwoFactorResponse, cErr CustomErr)
+	ConfirmTwoFactor(ctx context.Context, params ConfirmTwoFactorParams) (cErr CustomErr)
+	LoginTwoFactor(ctx context.Context, params LoginTwoFactorParams) (result dto.LoginResponseBody, cErr CustomErr)
+
+	// user
+	GetUserProfile(ctx context.Context, params GetUserProfileParams) (result dto.GetUserProfileResponse, cErr CustomErr)
+	UpdateUserProfile(ctx context.Context, params UpdateUserProfileParams) (result dto.GetUserProfileResponse, cErr CustomErr)
+	WithdrawUser(ctx context.Context, params WithdrawUserParams) (cErr CustomErr)
+	PurgeWithdrawnUsers(ctx context.Context) (count int64, cErr CustomErr)
+	UpdateUserRole(ctx context.Context, params UpdateUserRoleParams) (cErr CustomErr)
+
+	// api key
+	CreateApiKey(ctx context.Context, params CreateApiKeyParams) (result dto.CreateApiKeyResponse, cErr CustomErr)
+	GetApiKeyList(ctx context.Context, params GetApiKeyListParams) (result dto.GetApiKeyListResponse, cErr CustomErr)
+	RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (cErr CustomErr)
+	VerifyApiKey(ctx context.Context, key string) (payload *token.Payload, cErr CustomErr)
+
+	// session
+	GetSessionList(ctx context.Context, params GetSessionListParams) (result dto.GetSessionListResponse, cErr CustomErr)
+	DeleteSession(ctx context.Context, params DeleteSessionParams) (cErr CustomErr)
+	DeleteSessionList(ctx context.Context, params DeleteSessionListParams) (cErr CustomErr)
+	PurgeSessions(ctx context.Context) (count int64, cErr CustomErr)
+
+	// store
+	CreateStore(ctx context.Context, params CreateStoreParams) (result dto.CreateStoreResponse, cErr CustomErr)
+	GetStoreList(ctx context.Context, params GetStoreListParams) (result dto.GetStoreListResponse, cErr CustomErr)
+	CreateStoreInvitation(ctx context.Context, params CreateStoreInvitationParams) (cErr CustomErr)
+	GetStoreInvitationList(ctx context.Context, params GetStoreInvitationListParams) (result dto.GetStoreInvitationListResponse, cErr CustomErr)
+	AcceptStoreInvitation(ctx context.Context, params AcceptStoreInvitationParams) (cErr CustomErr)
+	DeleteStoreMember(ctx context.Context, params DeleteStoreMemberParams) (cErr CustomErr)
 
 	// product
 	CreateProduct(ctx context.Context, params CreateProductParams) (cErr CustomErr)
@@ -23,13 +71,42 @@ type Service interface {
 }
 
 type service struct {
-	config     util.Config
-	repository repository.Repository
+	config          util.Config
+	tokenMaker      token.TokenMaker
+	passwordHasher  hasher.PasswordHasher
+	phoneLimiter    *lockout.Limiter
+	ipLimiter       *lockout.Limiter
+	passwordPolicy  validator.PasswordPolicy
+	revocationStore revocation.Store
+	smsSender       sms.SMSSender
+	notifier        notifier.Notifier
+	cursorSigner    *cursor.Signer
+	repository      repository.Repository
 }
 
-func NewService(config util.Config, repository repository.Repository) Service {
+func NewService(config util.Config, tokenMaker token.TokenMaker, passwordHasher hasher.PasswordHasher, lockoutStore lockout.Store, revocationStore revocation.Store, smsSender sms.SMSSender, notifier notifier.Notifier, cursorSigner *cursor.Signer, repository repository.Repository) Service {
 	return &service{
-		config:     config,
-		repository: repository,
+		config:         config,
+		tokenMaker:     tokenMaker,
+		passwordHasher: passwordHasher,
+		phoneLimiter: lockout.NewLimiter(lockoutStore, lockout.Policy{
+			MaxAttempts:     config.LoginMaxAttempts,
+			LockDuration:    config.LoginLockDuration,
+			MaxLockDuration: config.LoginMaxLockDuration,
+		}),
+		ipLimiter: lockout.NewLimiter(lockoutStore, lockout.Policy{
+			MaxAttempts:     config.LoginMaxAttemptsPerIp,
+			LockDuration:    config.LoginLockDuration,
+			MaxLockDuration: config.LoginMaxLockDuration,
+		}),
+		passwordPolicy: validator.PasswordPolicy{
+			MinLength:      config.PasswordMinLength,
+			MinCharClasses: config.PasswordMinCharClasses,
+		},
+		revocationStore: revocationStore,
+		smsSender:       smsSender,
+		notifier:        notifier,
+		cursorSigner:    cursorSigner,
+		repository:      repository,
 	}
 }
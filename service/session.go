@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/rs/zerolog/log"
+)
+
+const exceptCurrentSession = "current"
+
+// 동시 세션 수 초과 시 정책
+const (
+	sessionLimitPolicyEvict  = "evict"
+	sessionLimitPolicyRefuse = "refuse"
+)
+
+type GetSessionListParams struct {
+	UserID    int64
+	SessionID string
+}
+
+// 로그인 세션 목록 조회 로직
+func (service *service) GetSessionList(ctx context.Context, params GetSessionListParams) (result dto.GetSessionListResponse, cErr CustomErr) {
+	// 활성 세션 검색
+	sessionList, err := service.repository.GetActiveSessionList(ctx, params.UserID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetSessionListResponse(sessionList, params.SessionID)
+	return
+}
+
+type DeleteSessionParams struct {
+	UserID int64
+	dto.DeleteSessionRequestPath
+}
+
+// 로그인 세션 삭제 로직
+func (service *service) DeleteSession(ctx context.Context, params DeleteSessionParams) (cErr CustomErr) {
+	// 세션 검색
+	session, err := service.repository.GetSession(ctx, params.ID)
+	if err != nil {
+		// 해당 id의 세션이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundSession
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 세션 회원 확인
+	if session.UserID != params.UserID {
+		cErr = errForbiddenSession
+		return
+	}
+
+	// 재발급된 세션까지 모두 차단
+	err = service.repository.BlockSessionFamily(ctx, session.FamilyID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 발급된 access 토큰 폐기
+	cErr = service.revokeSessionFamilyTokens(ctx, session.UserID, session.FamilyID)
+	return
+}
+
+type DeleteSessionListParams struct {
+	UserID    int64
+	SessionID string
+	dto.DeleteSessionListRequestQuery
+}
+
+// 로그인 세션 일괄 삭제 로직
+func (service *service) DeleteSessionList(ctx context.Context, params DeleteSessionListParams) (cErr CustomErr) {
+	// 현재 세션을 제외하지 않는 경우
+	if params.Except != exceptCurrentSession {
+		cErr = service.blockUserSessions(ctx, params.UserID)
+		return
+	}
+
+	// 현재 세션 외 모두 차단
+	cErr = service.blockOtherSessions(ctx, params.UserID, params.SessionID)
+	return
+}
+
+// 현재 세션(계열) 외 회원의 모든 세션 차단
+func (service *service) blockOtherSessions(ctx context.Context, userID int64, sessionID string) CustomErr {
+	// 현재 세션 검색
+	session, err := service.repository.GetSession(ctx, sessionID)
+	if err != nil {
+		// 해당 id의 세션이 없는 경우
+		if err == sql.ErrNoRows {
+			return errNotFoundSession
+		}
+
+		return NewErrInternalServer(err)
+	}
+
+	// 세션 회원 확인
+	if session.UserID != userID {
+		return errIncorrectSessionUser
+	}
+
+	arg := repository.BlockOtherSessionsParams{
+		UserID:   userID,
+		FamilyID: session.FamilyID,
+	}
+
+	err = service.repository.BlockOtherSessions(ctx, arg)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	// 발급된 access 토큰 폐기
+	return service.revokeSessionTokens(ctx, userID, func(other repository.Session) bool {
+		return other.FamilyID != session.FamilyID
+	})
+}
+
+// 동시 세션 수 제한 확인
+// 새 세션을 추가하면 최대 세션 수를 넘는 경우 refuse 정책은 로그인 거부, evict 정책은 오래된 세션부터 정리 대상으로 반환
+func (service *service) checkSessionLimit(ctx context.Context, userID int64) ([]repository.Session, CustomErr) {
+	if service.config.SessionMaxActive <= 0 {
+		return nil, CustomErr{}
+	}
+
+	// 최신순 정렬
+	sessionList, err := service.repository.GetActiveSessionList(ctx, userID)
+	if err != nil {
+		return nil, NewErrInternalServer(err)
+	}
+
+	overflow := len(sessionList) - service.config.SessionMaxActive + 1
+	if overflow <= 0 {
+		return nil, CustomErr{}
+	}
+
+	if service.config.SessionLimitPolicy == sessionLimitPolicyRefuse {
+		return nil, errTooManySessions
+	}
+
+	return sessionList[len(sessionList)-overflow:], CustomErr{}
+}
+
+// 동시 세션 수 제한으로 세션(계열) 차단 및 access 토큰 폐기
+func (service *service) evictSessions(ctx context.Context, sessionList []repository.Session) CustomErr {
+	for _, session := range sessionList {
+		err := service.repository.BlockSessionFamily(ctx, session.FamilyID)
+		if err != nil {
+			return NewErrInternalServer(err)
+		}
+
+		cErr := service.revokeSessionFamilyTokens(ctx, session.UserID, session.FamilyID)
+		if cErr.Err != nil {
+			return cErr
+		}
+
+		log.Info().Int64("user_id", session.UserID).Str("session_id", session.ID).Msg("session evicted by session limit")
+	}
+
+	return CustomErr{}
+}
+
+// 회원의 모든 세션 차단
+func (service *service) blockUserSessions(ctx context.Context, userID int64) CustomErr {
+	err := service.repository.BlockUserSessions(ctx, userID)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	// 발급된 access 토큰 폐기
+	return service.revokeSessionTokens(ctx, userID, func(session repository.Session) bool {
+		return true
+	})
+}
+
+// 같은 계열 세션의 access 토큰 폐기
+func (service *service) revokeSessionFamilyTokens(ctx context.Context, userID int64, familyID string) CustomErr {
+	return service.revokeSessionTokens(ctx, userID, func(session repository.Session) bool {
+		return session.FamilyID == familyID
+	})
+}
+
+// 세션으로 발급된 access 토큰 폐기
+// access 토큰은 세션 생성 시에만 발급되므로 access 토큰 유효 기간 내에 생성된 세션만 대상
+// access 토큰의 세션 ID(refresh 토큰 ID)를 폐기하여 세션의 access 토큰을 모두 무효화
+func (service *service) revokeSessionTokens(ctx context.Context, userID int64, match func(session repository.Session) bool) CustomErr {
+	arg := repository.GetRecentSessionListParams{
+		UserID:    userID,
+		CreatedAt: time.Now().Add(-service.config.AccessTokenDuration),
+	}
+
+	sessionList, err := service.repository.GetRecentSessionList(ctx, arg)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+
+	for _, session := range sessionList {
+		if !match(session) {
+			continue
+		}
+
+		err = service.revocationStore.Revoke(ctx, session.ID, session.CreatedAt.Add(service.config.AccessTokenDuration))
+		if err != nil {
+			return NewErrInternalServer(err)
+		}
+	}
+
+	return CustomErr{}
+}
+
+// 만료되었거나 차단된 지 보관 기간이 지난 세션 삭제 로직
+func (service *service) PurgeSessions(ctx context.Context) (count int64, cErr CustomErr) {
+	blockedAt := sql.NullTime{Time: time.Now().Add(-service.config.SessionRetentionPeriod), Valid: true}
+
+	count, err := service.repository.PurgeSessions(ctx, blockedAt)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,494 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSessionList(t *testing.T) {
+	user, _ := createRandomUser(t)
+	var sessionList []repository.Session
+	for i := 0; i < 3; i++ {
+		sessionList = append(sessionList, createRandomSession(t, user))
+	}
+
+	testCases := []struct {
+		name          string
+		params        GetSessionListParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetSessionListResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: GetSessionListParams{
+				UserID:    user.ID,
+				SessionID: sessionList[1].ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetActiveSessionList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(sessionList, nil)
+			},
+			checkResponse: func(result dto.GetSessionListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, len(sessionList))
+				for i, session := range result.List {
+					require.Equal(t, sessionList[i].ID, session.ID)
+					require.Equal(t, sessionList[i].ClientIp, session.ClientIp)
+					require.NotEmpty(t, session.Device.Type)
+					require.Equal(t, i == 1, session.IsCurrent)
+				}
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: GetSessionListParams{
+				UserID:    user.ID,
+				SessionID: sessionList[1].ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetActiveSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetSessionListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetSessionList(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestDeleteSession(t *testing.T) {
+	user, _ := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	testCases := []struct {
+		name          string
+		params        DeleteSessionParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: DeleteSessionParams{
+				UserID:                   user.ID,
+				DeleteSessionRequestPath: dto.DeleteSessionRequestPath{ID: session.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(session.ID)).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "세션이 없는 경우",
+			params: DeleteSessionParams{
+				UserID:                   user.ID,
+				DeleteSessionRequestPath: dto.DeleteSessionRequestPath{ID: session.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundSession)
+			},
+		},
+		{
+			name: "다른 회원의 세션인 경우",
+			params: DeleteSessionParams{
+				UserID:                   util.CreateRandomInt64(11, 20),
+				DeleteSessionRequestPath: dto.DeleteSessionRequestPath{ID: session.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenSession)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: DeleteSessionParams{
+				UserID:                   user.ID,
+				DeleteSessionRequestPath: dto.DeleteSessionRequestPath{ID: session.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockSessionFamily(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.DeleteSession(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestDeleteSessionList(t *testing.T) {
+	user, _ := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	testCases := []struct {
+		name          string
+		params        DeleteSessionListParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "전체 세션 삭제 성공",
+			params: DeleteSessionListParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "현재 세션 제외 삭제 성공",
+			params: DeleteSessionListParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				DeleteSessionListRequestQuery: dto.DeleteSessionListRequestQuery{
+					Except: exceptCurrentSession,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(session.ID)).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Eq(repository.BlockOtherSessionsParams{
+						UserID:   user.ID,
+						FamilyID: session.FamilyID,
+					})).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{session}, nil)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "현재 세션이 없는 경우",
+			params: DeleteSessionListParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+				DeleteSessionListRequestQuery: dto.DeleteSessionListRequestQuery{
+					Except: exceptCurrentSession,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Session{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundSession)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: DeleteSessionListParams{
+				UserID:    user.ID,
+				SessionID: session.ID,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.DeleteSessionList(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestPurgeSessions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(count int64, err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					PurgeSessions(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, blockedAt sql.NullTime) (int64, error) {
+						require.True(t, blockedAt.Valid)
+						require.WithinDuration(t, blockedAt.Time, time.Now().Add(-testConfig.SessionRetentionPeriod), time.Second)
+						return 5, nil
+					})
+			},
+			checkResponse: func(count int64, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, count, int64(5))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					PurgeSessions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(count int64, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+				require.Zero(t, count)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			count, err := service.PurgeSessions(context.Background())
+			tc.checkResponse(count, err)
+		})
+	}
+}
+
+func TestRevokeSessionTokens(t *testing.T) {
+	user, _ := createRandomUser(t)
+	session := createRandomSession(t, user)
+	otherSession := createRandomSession(t, user)
+	// 같은 계열에서 재발급 전에 사용된 세션
+	rotatedSession := createRandomSession(t, user)
+	rotatedSession.FamilyID = otherSession.FamilyID
+	rotatedSession.IsRotated = true
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(store revocation.Store, err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(session.ID)).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.GetRecentSessionListParams) ([]repository.Session, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.WithinDuration(t, time.Now().Add(-testConfig.AccessTokenDuration), arg.CreatedAt, time.Second)
+						return []repository.Session{session, otherSession, rotatedSession}, nil
+					})
+			},
+			checkResponse: func(store revocation.Store, err CustomErr) {
+				require.Empty(t, err)
+
+				// 현재 세션 외 세션의 access 토큰만 폐기
+				revoked, _ := store.IsRevoked(context.Background(), session.ID)
+				require.False(t, revoked)
+				revoked, _ = store.IsRevoked(context.Background(), otherSession.ID)
+				require.True(t, revoked)
+				revoked, _ = store.IsRevoked(context.Background(), rotatedSession.ID)
+				require.True(t, revoked)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(session, nil)
+				mockRepository.EXPECT().
+					BlockOtherSessions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, sql.ErrConnDone)
+			},
+			checkResponse: func(store revocation.Store, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			store := revocation.NewMemoryStore()
+			service := NewService(testConfig, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), store, sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, repository)
+
+			tc.buildStubs(repository)
+
+			params := DeleteSessionListParams{
+				UserID:                        user.ID,
+				SessionID:                     session.ID,
+				DeleteSessionListRequestQuery: dto.DeleteSessionListRequestQuery{Except: exceptCurrentSession},
+			}
+
+			err := service.DeleteSessionList(context.Background(), params)
+			tc.checkResponse(store, err)
+		})
+	}
+}
+
+func createRandomSession(t *testing.T, user repository.User) repository.Session {
+	id := uuid.NewString()
+
+	return repository.Session{
+		ID:           id,
+		UserID:       user.ID,
+		FamilyID:     id,
+		RefreshToken: util.CreateRandomString(50),
+		UserAgent:    userAgent,
+		ClientIp:     clientIp,
+		IsBlocked:    false,
+		ExpiredAt:    time.Now().Add(testConfig.RefreshTokenDuration),
+		CreatedAt:    time.Now(),
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/go-sql-driver/mysql"
+)
+
+type CreateStoreParams struct {
+	UserID int64
+	dto.CreateStoreRequestBody
+}
+
+// 매장 생성 로직
+func (service *service) CreateStore(ctx context.Context, params CreateStoreParams) (result dto.CreateStoreResponse, cErr CustomErr) {
+	storeID, cErr := service.createStore(ctx, params.UserID, params.Name)
+	if cErr.Err != nil {
+		return
+	}
+
+	result = dto.CreateStoreResponse{ID: storeID}
+	return
+}
+
+// 매장 생성 후 생성한 회원을 점주로 등록
+func (service *service) createStore(ctx context.Context, userID int64, name string) (storeID int64, cErr CustomErr) {
+	// 매장 생성
+	storeID, err := service.repository.CreateStore(ctx, name)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.CreateStoreMemberParams{
+		StoreID: storeID,
+		UserID:  userID,
+		Role:    repository.StoreMemberRoleOwner,
+	}
+
+	// 점주 등록
+	err = service.repository.CreateStoreMember(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	return
+}
+
+type GetStoreListParams struct {
+	UserID int64
+}
+
+// 소속 매장 목록 조회 로직
+func (service *service) GetStoreList(ctx context.Context, params GetStoreListParams) (result dto.GetStoreListResponse, cErr CustomErr) {
+	storeList, err := service.repository.GetStoreList(ctx, params.UserID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetStoreListResponse(storeList)
+	return
+}
+
+type CreateStoreInvitationParams struct {
+	UserID int64
+	dto.CreateStoreInvitationRequestPath
+	dto.CreateStoreInvitationRequestBody
+}
+
+// 매장 초대 로직 (점주 전용)
+func (service *service) CreateStoreInvitation(ctx context.Context, params CreateStoreInvitationParams) (cErr CustomErr) {
+	// 점주 확인
+	_, cErr = service.getStoreMember(ctx, params.UserID, params.ID, repository.StoreMemberRoleOwner)
+	if cErr.Err != nil {
+		return
+	}
+
+	arg := repository.CreateStoreInvitationParams{
+		StoreID:     params.ID,
+		PhoneNumber: params.PhoneNumber,
+		Role:        repository.StoreInvitationRole(params.Role),
+		InvitedBy:   params.UserID,
+		ExpiredAt:   time.Now().Add(service.config.StoreInvitationDuration),
+	}
+
+	// 초대 생성
+	err := service.repository.CreateStoreInvitation(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	return
+}
+
+type GetStoreInvitationListParams struct {
+	UserID int64
+}
+
+// 받은 매장 초대 목록 조회 로직
+func (service *service) GetStoreInvitationList(ctx context.Context, params GetStoreInvitationListParams) (result dto.GetStoreInvitationListResponse, cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 회원 휴대폰 번호로 받은 초대 검색
+	invitationList, err := service.repository.GetPendingStoreInvitationList(ctx, user.PhoneNumber)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetStoreInvitationListResponse(invitationList)
+	return
+}
+
+type AcceptStoreInvitationParams struct {
+	UserID int64
+	dto.AcceptStoreInvitationRequestPath
+}
+
+// 매장 초대 수락 로직
+func (service *service) AcceptStoreInvitation(ctx context.Context, params AcceptStoreInvitationParams) (cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 초대 검색
+	invitation, err := service.repository.GetStoreInvitation(ctx, params.ID)
+	if err != nil {
+		// 해당 id의 초대가 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundStoreInvitation
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 초대 받은 휴대폰 번호 확인
+	if invitation.PhoneNumber != user.PhoneNumber {
+		cErr = errForbiddenStoreInvitation
+		return
+	}
+
+	// 이미 수락한 초대인 경우
+	if invitation.AcceptedAt.Valid {
+		cErr = errAcceptedStoreInvitation
+		return
+	}
+
+	// 초대 유효 시간 확인
+	if time.Now().After(invitation.ExpiredAt) {
+		cErr = errExpiredStoreInvitation
+		return
+	}
+
+	// 이미 매장 회원인 경우
+	_, err = service.repository.GetStoreMember(ctx, repository.GetStoreMemberParams{
+		StoreID: invitation.StoreID,
+		UserID:  user.ID,
+	})
+	if err == nil {
+		cErr = errDuplicateStoreMember
+		return
+	}
+	if err != sql.ErrNoRows {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.CreateStoreMemberParams{
+		StoreID: invitation.StoreID,
+		UserID:  user.ID,
+		Role:    repository.StoreMemberRole(invitation.Role),
+	}
+
+	// 초대 수락 처리 후 매장 회원 등록
+	// 동시 요청으로 이미 수락된 경우 영향받은 row가 없음
+	rows, err := service.repository.AcceptStoreInvitationTx(ctx, invitation.ID, arg)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			// 이미 매장 회원인 경우
+			case repository.DB_DUPLICATE_ERROR:
+				cErr = errDuplicateStoreMember
+				return
+			// 매장이 없는 경우
+			case repository.DB_FK_ERROR:
+				cErr = errNotFoundStore
+				return
+			}
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	if rows == 0 {
+		cErr = errAcceptedStoreInvitation
+		return
+	}
+
+	return
+}
+
+type DeleteStoreMemberParams struct {
+	UserID int64
+	dto.DeleteStoreMemberRequestPath
+}
+
+// 매장 회원 삭제 로직 (점주 전용)
+func (service *service) DeleteStoreMember(ctx context.Context, params DeleteStoreMemberParams) (cErr CustomErr) {
+	// 점주 확인
+	_, cErr = service.getStoreMember(ctx, params.UserID, params.ID, repository.StoreMemberRoleOwner)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 점주 본인은 삭제 불가
+	if params.DeleteStoreMemberRequestPath.UserID == params.UserID {
+		cErr = errRemoveSelfStoreMember
+		return
+	}
+
+	arg := repository.DeleteStoreMemberParams{
+		StoreID: params.ID,
+		UserID:  params.DeleteStoreMemberRequestPath.UserID,
+	}
+
+	// 매장 회원 삭제
+	rows, err := service.repository.DeleteStoreMember(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	if rows == 0 {
+		cErr = errNotFoundStoreMember
+		return
+	}
+
+	return
+}
+
+// 요청 매장의 회원 정보 조회 함수
+// storeID가 0인 경우 회원의 기본 매장 사용, roles 입력 시 매장 역할까지 확인
+func (service *service) getStoreMember(ctx context.Context, userID int64, storeID int64, roles ...repository.StoreMemberRole) (member repository.StoreMember, cErr CustomErr) {
+	var err error
+	if storeID == 0 {
+		member, err = service.repository.GetDefaultStoreMember(ctx, userID)
+	} else {
+		member, err = service.repository.GetStoreMember(ctx, repository.GetStoreMemberParams{
+			StoreID: storeID,
+			UserID:  userID,
+		})
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// 소속 매장이 없는 경우
+			if storeID == 0 {
+				cErr = errNotFoundStore
+				return
+			}
+
+			// 매장 회원이 아닌 경우
+			cErr = errForbiddenStore
+			return
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	if !hasStoreMemberRole(member, roles) {
+		cErr = errForbiddenStoreRole
+		return
+	}
+
+	return
+}
+
+// 매장 역할 확인 함수 (roles가 비어있으면 모든 역할 허용)
+func hasStoreMemberRole(member repository.StoreMember, roles []repository.StoreMemberRole) bool {
+	if len(roles) == 0 {
+		return true
+	}
+
+	for _, role := range roles {
+		if member.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,745 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStore(t *testing.T) {
+	user, _ := createRandomUser(t)
+	storeID := util.CreateRandomInt64(1, 10)
+	name := util.CreateRandomString(10)
+
+	testCases := []struct {
+		name          string
+		params        CreateStoreParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.CreateStoreResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: CreateStoreParams{
+				UserID:                 user.ID,
+				CreateStoreRequestBody: dto.CreateStoreRequestBody{Name: name},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateStore(gomock.Any(), gomock.Eq(name)).
+					Times(1).
+					Return(storeID, nil)
+				mockRepository.EXPECT().
+					CreateStoreMember(gomock.Any(), gomock.Eq(repository.CreateStoreMemberParams{
+						StoreID: storeID,
+						UserID:  user.ID,
+						Role:    repository.StoreMemberRoleOwner,
+					})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.CreateStoreResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, result.ID, storeID)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: CreateStoreParams{
+				UserID:                 user.ID,
+				CreateStoreRequestBody: dto.CreateStoreRequestBody{Name: name},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					CreateStore(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+				mockRepository.EXPECT().
+					CreateStoreMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.CreateStoreResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.CreateStore(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestGetStoreList(t *testing.T) {
+	user, _ := createRandomUser(t)
+	storeList := []repository.GetStoreListRow{
+		{ID: 1, Name: util.CreateRandomString(10), Role: repository.StoreMemberRoleOwner, CreatedAt: time.Now()},
+		{ID: 2, Name: util.CreateRandomString(10), Role: repository.StoreMemberRoleStaff, CreatedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetStoreListResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(storeList, nil)
+			},
+			checkResponse: func(result dto.GetStoreListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, len(storeList))
+				for idx, store := range result.List {
+					require.Equal(t, store.ID, storeList[idx].ID)
+					require.Equal(t, store.Name, storeList[idx].Name)
+					require.Equal(t, store.Role, storeList[idx].Role)
+				}
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return([]repository.GetStoreListRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetStoreListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetStoreList(context.Background(), GetStoreListParams{UserID: user.ID})
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestCreateStoreInvitation(t *testing.T) {
+	owner, _ := createRandomUser(t)
+	ownerMember := createRandomStoreMember(t, owner, repository.StoreMemberRoleOwner)
+	staffMember := ownerMember
+	staffMember.Role = repository.StoreMemberRoleStaff
+	phoneNumber := util.CreateRandomPhoneNumber()
+
+	params := CreateStoreInvitationParams{
+		UserID:                           owner.ID,
+		CreateStoreInvitationRequestPath: dto.CreateStoreInvitationRequestPath{ID: ownerMember.StoreID},
+		CreateStoreInvitationRequestBody: dto.CreateStoreInvitationRequestBody{
+			PhoneNumber: phoneNumber,
+			Role:        string(repository.StoreInvitationRoleStaff),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: ownerMember.StoreID, UserID: owner.ID})).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.CreateStoreInvitationParams) error {
+						require.Equal(t, arg.StoreID, ownerMember.StoreID)
+						require.Equal(t, arg.PhoneNumber, phoneNumber)
+						require.Equal(t, arg.Role, repository.StoreInvitationRoleStaff)
+						require.Equal(t, arg.InvitedBy, owner.ID)
+						require.WithinDuration(t, arg.ExpiredAt, time.Now().Add(testConfig.StoreInvitationDuration), time.Second)
+						return nil
+					})
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "매장 회원이 아닌 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStore)
+			},
+		},
+		{
+			name: "직원인 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+				mockRepository.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreRole)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					CreateStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.CreateStoreInvitation(context.Background(), params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestGetStoreInvitationList(t *testing.T) {
+	user, _ := createRandomUser(t)
+	invitation := createRandomStoreInvitation(t, util.CreateRandomInt64(1, 10), user.PhoneNumber)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetStoreInvitationListResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetPendingStoreInvitationList(gomock.Any(), gomock.Eq(user.PhoneNumber)).
+					Times(1).
+					Return([]repository.StoreInvitation{invitation}, nil)
+			},
+			checkResponse: func(result dto.GetStoreInvitationListResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Len(t, result.List, 1)
+				require.Equal(t, result.List[0].ID, invitation.ID)
+				require.Equal(t, result.List[0].StoreID, invitation.StoreID)
+				require.Equal(t, result.List[0].Role, invitation.Role)
+				require.Equal(t, result.List[0].InvitedBy, invitation.InvitedBy)
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					GetPendingStoreInvitationList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetStoreInvitationListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetPendingStoreInvitationList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.StoreInvitation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetStoreInvitationListResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetStoreInvitationList(context.Background(), GetStoreInvitationListParams{UserID: user.ID})
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestAcceptStoreInvitation(t *testing.T) {
+	user, _ := createRandomUser(t)
+	invitation := createRandomStoreInvitation(t, util.CreateRandomInt64(1, 10), user.PhoneNumber)
+
+	otherInvitation := invitation
+	otherInvitation.PhoneNumber = util.CreateRandomPhoneNumber()
+
+	acceptedInvitation := invitation
+	acceptedInvitation.AcceptedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	expiredInvitation := invitation
+	expiredInvitation.ExpiredAt = time.Now().Add(-time.Minute)
+
+	member := repository.StoreMember{
+		StoreID:   invitation.StoreID,
+		UserID:    user.ID,
+		Role:      repository.StoreMemberRoleStaff,
+		CreatedAt: time.Now(),
+	}
+
+	params := AcceptStoreInvitationParams{
+		UserID:                           user.ID,
+		AcceptStoreInvitationRequestPath: dto.AcceptStoreInvitationRequestPath{ID: invitation.ID},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Eq(invitation.ID)).
+					Times(1).
+					Return(invitation, nil)
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: invitation.StoreID, UserID: user.ID})).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Eq(invitation.ID), gomock.Eq(repository.CreateStoreMemberParams{
+						StoreID: invitation.StoreID,
+						UserID:  user.ID,
+						Role:    repository.StoreMemberRoleStaff,
+					})).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "초대가 없는 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreInvitation{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundStoreInvitation)
+			},
+		},
+		{
+			name: "다른 휴대폰 번호로 받은 초대",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(otherInvitation, nil)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreInvitation)
+			},
+		},
+		{
+			name: "이미 수락한 초대",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(acceptedInvitation, nil)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errAcceptedStoreInvitation)
+			},
+		},
+		{
+			name: "만료된 초대",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(expiredInvitation, nil)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errExpiredStoreInvitation)
+			},
+		},
+		{
+			name: "이미 매장 회원인 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(invitation, nil)
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(member, nil)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errDuplicateStoreMember)
+			},
+		},
+		{
+			name: "동시 요청으로 이미 수락된 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(invitation, nil)
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errAcceptedStoreInvitation)
+			},
+		},
+		{
+			name: "매장이 없는 경우",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(invitation, nil)
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreMember{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					AcceptStoreInvitationTx(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), &mysql.MySQLError{Number: repository.DB_FK_ERROR, Message: "store_id"})
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundStore)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetStoreInvitation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.StoreInvitation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.AcceptStoreInvitation(context.Background(), params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestDeleteStoreMember(t *testing.T) {
+	owner, _ := createRandomUser(t)
+	ownerMember := createRandomStoreMember(t, owner, repository.StoreMemberRoleOwner)
+	staffMember := ownerMember
+	staffMember.Role = repository.StoreMemberRoleStaff
+	staffID := util.CreateRandomInt64(11, 20)
+
+	testCases := []struct {
+		name          string
+		params        DeleteStoreMemberParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: DeleteStoreMemberParams{
+				UserID:                       owner.ID,
+				DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: ownerMember.StoreID, UserID: staffID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Eq(repository.GetStoreMemberParams{StoreID: ownerMember.StoreID, UserID: owner.ID})).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Eq(repository.DeleteStoreMemberParams{StoreID: ownerMember.StoreID, UserID: staffID})).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "직원인 경우",
+			params: DeleteStoreMemberParams{
+				UserID:                       owner.ID,
+				DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: ownerMember.StoreID, UserID: staffID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(staffMember, nil)
+				mockRepository.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errForbiddenStoreRole)
+			},
+		},
+		{
+			name: "본인을 삭제하는 경우",
+			params: DeleteStoreMemberParams{
+				UserID:                       owner.ID,
+				DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: ownerMember.StoreID, UserID: owner.ID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errRemoveSelfStoreMember)
+			},
+		},
+		{
+			name: "매장 회원이 없는 경우",
+			params: DeleteStoreMemberParams{
+				UserID:                       owner.ID,
+				DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: ownerMember.StoreID, UserID: staffID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundStoreMember)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: DeleteStoreMemberParams{
+				UserID:                       owner.ID,
+				DeleteStoreMemberRequestPath: dto.DeleteStoreMemberRequestPath{ID: ownerMember.StoreID, UserID: staffID},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(ownerMember, nil)
+				mockRepository.EXPECT().
+					DeleteStoreMember(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.DeleteStoreMember(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func createRandomStoreMember(t *testing.T, user repository.User, role repository.StoreMemberRole) repository.StoreMember {
+	member := repository.StoreMember{
+		StoreID:   util.CreateRandomInt64(1, 10),
+		UserID:    user.ID,
+		Role:      role,
+		CreatedAt: time.Now(),
+	}
+
+	return member
+}
+
+func createRandomStoreInvitation(t *testing.T, storeID int64, phoneNumber string) repository.StoreInvitation {
+	invitation := repository.StoreInvitation{
+		ID:          util.CreateRandomInt64(1, 10),
+		StoreID:     storeID,
+		PhoneNumber: phoneNumber,
+		Role:        repository.StoreInvitationRoleStaff,
+		InvitedBy:   util.CreateRandomInt64(11, 20),
+		ExpiredAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+	}
+
+	return invitation
+}
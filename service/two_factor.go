@@ -0,0 +1,396 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"encoding/base64"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	qrCodeSize         = 200
+	// TOTP 코드 갱신 주기 (초)
+	totpPeriod = 30
+)
+
+type EnrollTwoFactorParams struct {
+	UserID int64
+	dto.EnrollTwoFactorRequestBody
+}
+
+// 2단계 인증 등록 로직
+// 확인 전까지는 비활성 상태이며, 다시 등록하면 비밀키와 복구 코드를 새로 발급
+func (service *service) EnrollTwoFactor(ctx context.Context, params EnrollTwoFactorParams) (result dto.EnrollTwoFactorResponse, cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 비밀번호 검증 (토큰 탈취만으로 비밀키, 복구 코드를 바꾸지 못하도록)
+	err = service.passwordHasher.CheckPassword(params.Password, user.HashedPassword)
+	if err != nil {
+		cErr = errWrongPassword
+		return
+	}
+
+	// 이미 2단계 인증을 사용하는 경우
+	if user.IsTotpEnabled {
+		cErr = errEnabledTwoFactor
+		return
+	}
+
+	// TOTP 비밀키 생성
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      service.config.TOTPIssuer,
+		AccountName: user.PhoneNumber,
+	})
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// QR 코드 생성
+	img, err := key.Image(qrCodeSize, qrCodeSize)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	var buf bytes.Buffer
+	err = png.Encode(&buf, img)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.UpdateUserTotpSecretParams{
+		TotpSecret: sql.NullString{String: key.Secret(), Valid: true},
+		ID:         user.ID,
+	}
+
+	// 비밀키 저장
+	err = service.repository.UpdateUserTotpSecret(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 복구 코드 발급
+	recoveryCodes, cErr := service.issueRecoveryCodes(ctx, user.ID)
+	if cErr.Err != nil {
+		return
+	}
+
+	result = dto.EnrollTwoFactorResponse{
+		Secret:        key.Secret(),
+		URI:           key.URL(),
+		QRCode:        base64.StdEncoding.EncodeToString(buf.Bytes()),
+		RecoveryCodes: recoveryCodes,
+	}
+
+	return
+}
+
+// 복구 코드 발급 (기존 복구 코드는 삭제)
+func (service *service) issueRecoveryCodes(ctx context.Context, userID int64) ([]string, CustomErr) {
+	err := service.repository.DeleteRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, NewErrInternalServer(err)
+	}
+
+	recoveryCodes := make([]string, recoveryCodeCount)
+	for i := range recoveryCodes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, NewErrInternalServer(err)
+		}
+
+		hashedCode, err := service.passwordHasher.HashPassword(code)
+		if err != nil {
+			return nil, NewErrInternalServer(err)
+		}
+
+		err = service.repository.CreateRecoveryCode(ctx, repository.CreateRecoveryCodeParams{
+			UserID:     userID,
+			HashedCode: hashedCode,
+		})
+		if err != nil {
+			return nil, NewErrInternalServer(err)
+		}
+
+		// 읽기 쉽도록 5자리씩 구분
+		recoveryCodes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+
+	return recoveryCodes, CustomErr{}
+}
+
+// 복구 코드 생성 함수 (소문자, 숫자)
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+
+	code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)
+
+	return strings.ToLower(code[:recoveryCodeLength]), nil
+}
+
+// 입력한 복구 코드 정규화 함수 (구분자, 공백 제거)
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+type ConfirmTwoFactorParams struct {
+	UserID int64
+	dto.ConfirmTwoFactorRequestBody
+}
+
+// 2단계 인증 등록 확인 로직
+func (service *service) ConfirmTwoFactor(ctx context.Context, params ConfirmTwoFactorParams) (cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 이미 2단계 인증을 사용하는 경우
+	if user.IsTotpEnabled {
+		cErr = errEnabledTwoFactor
+		return
+	}
+	// 2단계 인증을 등록하지 않은 경우
+	if !user.TotpSecret.Valid {
+		cErr = errNotEnrolledTwoFactor
+		return
+	}
+	// 인증 코드가 일치하지 않거나 이미 사용한 경우
+	ok, cErr := service.useTotpCode(ctx, user, params.Code)
+	if cErr.Err != nil {
+		return
+	}
+	if !ok {
+		cErr = errWrongTwoFactorCode
+		return
+	}
+
+	// 2단계 인증 활성화
+	err = service.repository.EnableUserTotp(ctx, user.ID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	log.Info().Int64("user_id", user.ID).Msg("two factor authentication enabled")
+
+	return
+}
+
+type LoginTwoFactorParams struct {
+	dto.LoginTwoFactorRequestBody
+	UserAgent string
+	ClientIp  string
+}
+
+// 2단계 인증 로그인 로직
+func (service *service) LoginTwoFactor(ctx context.Context, params LoginTwoFactorParams) (result dto.LoginResponseBody, cErr CustomErr) {
+	event := authEvent{
+		Type:      repository.AuthEventTypeLoginTwoFactor,
+		UserAgent: params.UserAgent,
+		ClientIp:  params.ClientIp,
+	}
+	defer func() { service.recordAuthEvent(ctx, event, cErr) }()
+
+	// 인증 대기 토큰 검증
+	payload, err := service.tokenMaker.VerifyToken(params.TwoFactorToken)
+	if err != nil {
+		cErr = NewErrBadRequest(err)
+		return
+	}
+	event.UserID = payload.UserID
+	if payload.Purpose != token.PurposeTwoFactor {
+		cErr = errInvalidTwoFactorToken
+		return
+	}
+	// 이미 사용한 인증 대기 토큰인 경우
+	revoked, err := service.revocationStore.IsRevoked(ctx, payload.ID)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	if revoked {
+		cErr = errInvalidTwoFactorToken
+		return
+	}
+
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	event.PhoneNumber = user.PhoneNumber
+	// 탈퇴 유예 기간이 지나 삭제 대기 중인 경우
+	if service.isPurgeableUser(user) {
+		cErr = errNotFoundUser
+		return
+	}
+	// 2단계 인증을 사용하지 않는 경우
+	if !user.IsTotpEnabled || !user.TotpSecret.Valid {
+		cErr = errNotEnrolledTwoFactor
+		return
+	}
+
+	// 인증 코드 입력도 로그인 잠금 정책 적용
+	loginParams := LoginParams{
+		LoginRequestBody: dto.LoginRequestBody{PhoneNumber: user.PhoneNumber},
+		UserAgent:        params.UserAgent,
+		ClientIp:         params.ClientIp,
+	}
+
+	// 로그인 잠금 검증
+	cErr = service.checkLoginLock(ctx, loginParams)
+	if cErr.Err != nil {
+		return
+	}
+
+	// 인증 코드 검증
+	if params.Code != "" {
+		var ok bool
+		ok, cErr = service.useTotpCode(ctx, user, params.Code)
+		if cErr.Err != nil {
+			return
+		}
+		if !ok {
+			cErr = service.failLogin(ctx, loginParams, errWrongTwoFactorCode)
+			return
+		}
+	} else {
+		var ok bool
+		ok, cErr = service.useRecoveryCode(ctx, user.ID, params.RecoveryCode)
+		if cErr.Err != nil {
+			return
+		}
+		if !ok {
+			cErr = service.failLogin(ctx, loginParams, errWrongTwoFactorCode)
+			return
+		}
+
+		log.Info().Int64("user_id", user.ID).Msg("recovery code used")
+	}
+
+	// 인증 대기 토큰은 한 번만 사용 가능
+	err = service.revocationStore.Revoke(ctx, payload.ID, payload.ExpiredAt)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 휴대폰 번호 실패 기록 초기화
+	err = service.phoneLimiter.Reset(ctx, phoneLockoutKey(user.PhoneNumber))
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result, cErr = service.completeLogin(ctx, user, params.UserAgent, params.ClientIp)
+	return
+}
+
+// TOTP 코드 검증 함수 (앞뒤 1 스텝 허용)
+// 일치한 코드의 스텝 반환
+func validateTotpCode(code string, secret string, now time.Time) (int64, bool) {
+	for _, skew := range []int64{0, -1, 1} {
+		t := now.Add(time.Duration(skew*totpPeriod) * time.Second)
+		ok, err := totp.ValidateCustom(code, secret, t, totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && ok {
+			return t.Unix() / totpPeriod, true
+		}
+	}
+
+	return 0, false
+}
+
+// TOTP 코드 검증 및 사용 처리
+// 마지막으로 사용한 스텝 이하의 코드는 재사용으로 보고 거부
+func (service *service) useTotpCode(ctx context.Context, user repository.User, code string) (bool, CustomErr) {
+	step, ok := validateTotpCode(code, user.TotpSecret.String, time.Now())
+	if !ok {
+		return false, CustomErr{}
+	}
+
+	rows, err := service.repository.UpdateUserTotpLastStep(ctx, repository.UpdateUserTotpLastStepParams{
+		TotpLastStep: step,
+		ID:           user.ID,
+	})
+	if err != nil {
+		return false, NewErrInternalServer(err)
+	}
+
+	// 이미 사용한 코드이거나 동시에 같은 코드를 사용한 경우
+	return rows > 0, CustomErr{}
+}
+
+// 복구 코드 검증 및 사용 처리
+func (service *service) useRecoveryCode(ctx context.Context, userID int64, code string) (bool, CustomErr) {
+	recoveryCodeList, err := service.repository.GetUnusedRecoveryCodeList(ctx, userID)
+	if err != nil {
+		return false, NewErrInternalServer(err)
+	}
+
+	code = normalizeRecoveryCode(code)
+	for _, recoveryCode := range recoveryCodeList {
+		if service.passwordHasher.CheckPassword(code, recoveryCode.HashedCode) != nil {
+			continue
+		}
+
+		rows, err := service.repository.UseRecoveryCode(ctx, recoveryCode.ID)
+		if err != nil {
+			return false, NewErrInternalServer(err)
+		}
+
+		// 동시에 같은 복구 코드를 사용한 경우
+		return rows > 0, CustomErr{}
+	}
+
+	return false, CustomErr{}
+}
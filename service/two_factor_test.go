@@ -0,0 +1,697 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/golang/mock/gomock"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollTwoFactor(t *testing.T) {
+	user, password := createRandomUser(t)
+
+	enabledUser := user
+	enabledUser.IsTotpEnabled = true
+
+	testCases := []struct {
+		name          string
+		params        EnrollTwoFactorParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.EnrollTwoFactorResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: EnrollTwoFactorParams{
+				UserID:                     user.ID,
+				EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.UpdateUserTotpSecretParams) error {
+						require.Equal(t, arg.ID, user.ID)
+						require.True(t, arg.TotpSecret.Valid)
+						require.NotEmpty(t, arg.TotpSecret.String)
+						return nil
+					})
+				mockRepository.EXPECT().
+					DeleteRecoveryCodes(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					CreateRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(recoveryCodeCount).
+					Return(nil)
+			},
+			checkResponse: func(result dto.EnrollTwoFactorResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, result.Secret)
+				require.True(t, strings.HasPrefix(result.URI, "otpauth://totp/"))
+				require.NotEmpty(t, result.QRCode)
+				require.Len(t, result.RecoveryCodes, recoveryCodeCount)
+				for _, recoveryCode := range result.RecoveryCodes {
+					require.Len(t, normalizeRecoveryCode(recoveryCode), recoveryCodeLength)
+				}
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			params: EnrollTwoFactorParams{
+				UserID:                     user.ID,
+				EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					UpdateUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.EnrollTwoFactorResponse, err CustomErr) {
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "비밀번호가 틀린 경우",
+			params: EnrollTwoFactorParams{
+				UserID:                     user.ID,
+				EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password + "1"},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					DeleteRecoveryCodes(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.EnrollTwoFactorResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errWrongPassword)
+			},
+		},
+		{
+			name: "이미 2단계 인증을 사용하는 경우",
+			params: EnrollTwoFactorParams{
+				UserID:                     user.ID,
+				EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enabledUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.EnrollTwoFactorResponse, err CustomErr) {
+				require.Equal(t, err, errEnabledTwoFactor)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: EnrollTwoFactorParams{
+				UserID:                     user.ID,
+				EnrollTwoFactorRequestBody: dto.EnrollTwoFactorRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpSecret(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					CreateRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.EnrollTwoFactorResponse, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.EnrollTwoFactor(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	enrolledUser, secret := createRandomTwoFactorUser(t, user)
+	enrolledUser.IsTotpEnabled = false
+
+	enabledUser := enrolledUser
+	enabledUser.IsTotpEnabled = true
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		params        ConfirmTwoFactorParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: code},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enrolledUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "등록하지 않은 경우",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: code},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotEnrolledTwoFactor)
+			},
+		},
+		{
+			name: "이미 2단계 인증을 사용하는 경우",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: code},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enabledUser, nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errEnabledTwoFactor)
+			},
+		},
+		{
+			name: "인증 코드가 틀린 경우",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: createWrongVerificationCode(code)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enrolledUser, nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongTwoFactorCode)
+			},
+		},
+		{
+			name: "이미 사용한 인증 코드",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: code},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enrolledUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongTwoFactorCode)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: ConfirmTwoFactorParams{
+				UserID:                      user.ID,
+				ConfirmTwoFactorRequestBody: dto.ConfirmTwoFactorRequestBody{Code: code},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(enrolledUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					EnableUserTotp(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.ConfirmTwoFactor(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	user, _ := createRandomUser(t)
+	twoFactorUser, secret := createRandomTwoFactorUser(t, user)
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+
+	twoFactorToken, _, err := testTokenMaker.CreatePurposeToken(user.ID, token.PurposeTwoFactor, time.Minute)
+	require.NoError(t, err)
+	accessToken, _, err := testTokenMaker.CreateToken(user.ID, "", "", time.Minute)
+	require.NoError(t, err)
+
+	recoveryCode, err := generateRecoveryCode()
+	require.NoError(t, err)
+	hashedRecoveryCode, err := testPasswordHasher.HashPassword(recoveryCode)
+	require.NoError(t, err)
+	recoveryCodeList := []repository.RecoveryCode{
+		{ID: 1, UserID: user.ID, HashedCode: hashedRecoveryCode, CreatedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name          string
+		params        LoginTwoFactorParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.LoginResponseBody, err CustomErr)
+	}{
+		{
+			name: "인증 코드로 성공",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					Code:           code,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					GetUnusedRecoveryCodeList(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, err)
+				payload, _ := testTokenMaker.VerifyToken(result.AccessToken)
+				require.Equal(t, user.ID, payload.UserID)
+				require.Empty(t, payload.Purpose)
+				require.NotEmpty(t, result.RefreshToken)
+			},
+		},
+		{
+			name: "복구 코드로 성공",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					RecoveryCode:   strings.ToUpper(recoveryCode[:5] + "-" + recoveryCode[5:]),
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					GetUnusedRecoveryCodeList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(recoveryCodeList, nil)
+				mockRepository.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(recoveryCodeList[0].ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, result.AccessToken)
+			},
+		},
+		{
+			name: "이미 사용한 복구 코드",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					RecoveryCode:   recoveryCode,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					GetUnusedRecoveryCodeList(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return([]repository.RecoveryCode{}, nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, errWrongTwoFactorCode)
+			},
+		},
+		{
+			name: "인증 코드가 틀린 경우",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					Code:           createWrongVerificationCode(code),
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, errWrongTwoFactorCode)
+			},
+		},
+		{
+			name: "이미 사용한 인증 코드",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					Code:           code,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(twoFactorUser, nil)
+				mockRepository.EXPECT().
+					UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, errWrongTwoFactorCode)
+			},
+		},
+		{
+			name: "인증 대기 토큰이 아닌 경우",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: accessToken,
+					Code:           code,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, errInvalidTwoFactorToken)
+			},
+		},
+		{
+			name: "2단계 인증을 사용하지 않는 경우",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					Code:           code,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, errNotEnrolledTwoFactor)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: LoginTwoFactorParams{
+				LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+					TwoFactorToken: twoFactorToken,
+					Code:           code,
+				},
+				UserAgent: userAgent,
+				ClientIp:  clientIp,
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.LoginResponseBody, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+			repository.EXPECT().
+				CreateAuthEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+			repository.EXPECT().
+				GetKnownDeviceList(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(nil, nil)
+			repository.EXPECT().
+				CreateKnownDevice(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			result, err := service.LoginTwoFactor(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestLoginTwoFactorSingleUseToken(t *testing.T) {
+	user, _ := createRandomUser(t)
+	twoFactorUser, secret := createRandomTwoFactorUser(t, user)
+
+	twoFactorToken, _, err := testTokenMaker.CreatePurposeToken(user.ID, token.PurposeTwoFactor, time.Minute)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mockrepository.NewMockRepository(ctrl)
+	service := newTestService(t, repository)
+
+	repository.EXPECT().
+		GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+		Times(1).
+		Return(twoFactorUser, nil)
+	repository.EXPECT().
+		UpdateUserTotpLastStep(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(int64(1), nil)
+	repository.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+	repository.EXPECT().
+		CreateAuthEvent(gomock.Any(), gomock.Any()).
+		AnyTimes()
+	repository.EXPECT().
+		GetKnownDeviceList(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(nil, nil)
+	repository.EXPECT().
+		CreateKnownDevice(gomock.Any(), gomock.Any()).
+		AnyTimes()
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+	params := LoginTwoFactorParams{
+		LoginTwoFactorRequestBody: dto.LoginTwoFactorRequestBody{
+			TwoFactorToken: twoFactorToken,
+			Code:           code,
+		},
+		UserAgent: userAgent,
+		ClientIp:  clientIp,
+	}
+
+	_, cErr := service.LoginTwoFactor(context.Background(), params)
+	require.Empty(t, cErr)
+
+	// 같은 인증 대기 토큰으로 다시 로그인하는 경우
+	_, cErr = service.LoginTwoFactor(context.Background(), params)
+	require.Equal(t, errInvalidTwoFactorToken, cErr)
+}
+
+func TestValidateTotpCode(t *testing.T) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      testConfig.TOTPIssuer,
+		AccountName: util.CreateRandomPhoneNumber(),
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	currentStep := now.Unix() / totpPeriod
+
+	for _, skew := range []int64{-1, 0, 1} {
+		code, err := totp.GenerateCode(key.Secret(), now.Add(time.Duration(skew*totpPeriod)*time.Second))
+		require.NoError(t, err)
+
+		step, ok := validateTotpCode(code, key.Secret(), now)
+		require.True(t, ok)
+		require.Equal(t, currentStep+skew, step)
+	}
+
+	// 허용 범위를 벗어난 코드
+	code, err := totp.GenerateCode(key.Secret(), now.Add(-2*totpPeriod*time.Second))
+	require.NoError(t, err)
+	_, ok := validateTotpCode(code, key.Secret(), now)
+	require.False(t, ok)
+}
+
+func createRandomTwoFactorUser(t *testing.T, user repository.User) (repository.User, string) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      testConfig.TOTPIssuer,
+		AccountName: user.PhoneNumber,
+	})
+	require.NoError(t, err)
+
+	user.TotpSecret = sql.NullString{String: key.Secret(), Valid: true}
+	user.IsTotpEnabled = true
+
+	return user, key.Secret()
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/go-sql-driver/mysql"
+	"github.com/rs/zerolog/log"
+)
+
+type GetUserProfileParams struct {
+	UserID int64
+}
+
+// 내 정보 조회 로직
+func (service *service) GetUserProfile(ctx context.Context, params GetUserProfileParams) (result dto.GetUserProfileResponse, cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	result = dto.NewGetUserProfileResponse(user)
+	return
+}
+
+type UpdateUserProfileParams struct {
+	UserID int64
+	dto.UpdateUserProfileRequestBody
+}
+
+// 내 정보 수정 로직
+func (service *service) UpdateUserProfile(ctx context.Context, params UpdateUserProfileParams) (result dto.GetUserProfileResponse, cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// mysql의 coalesce 기능 구현
+	if params.DisplayName != nil {
+		user.DisplayName = sql.NullString{String: *params.DisplayName, Valid: *params.DisplayName != ""}
+	}
+	if params.ShopName != nil {
+		user.ShopName = sql.NullString{String: *params.ShopName, Valid: *params.ShopName != ""}
+	}
+	if params.Timezone != nil {
+		user.Timezone = *params.Timezone
+	}
+
+	// 휴대폰 번호가 바뀌는 경우 새 휴대폰 번호 인증 검증
+	isPhoneNumberChanged := params.PhoneNumber != nil && *params.PhoneNumber != user.PhoneNumber
+	if isPhoneNumberChanged {
+		cErr = service.verifyCode(ctx, *params.PhoneNumber, repository.VerificationPurposeChangePhoneNumber, *params.VerificationCode)
+		if cErr.Err != nil {
+			return
+		}
+
+		user.PhoneNumber = *params.PhoneNumber
+	}
+
+	arg := repository.UpdateUserProfileParams{
+		PhoneNumber: user.PhoneNumber,
+		DisplayName: user.DisplayName,
+		ShopName:    user.ShopName,
+		Timezone:    user.Timezone,
+		ID:          user.ID,
+	}
+
+	// 회원 정보 수정
+	err = service.repository.UpdateUserProfile(ctx, arg)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			// 휴대폰 번호가 중복된 경우
+			case repository.DB_DUPLICATE_ERROR:
+				switch true {
+				case strings.Contains(mysqlErr.Message, "phone_number"):
+					cErr = errDuplicatePhoneNumber
+					return
+				}
+			}
+		}
+
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	if isPhoneNumberChanged {
+		log.Info().Int64("user_id", user.ID).Msg("user phone number changed")
+	}
+
+	result = dto.NewGetUserProfileResponse(user)
+	return
+}
+
+type WithdrawUserParams struct {
+	UserID int64
+	dto.WithdrawUserRequestBody
+}
+
+// 회원 탈퇴 로직
+// 유예 기간 동안은 삭제 예정으로만 표시하고, 유예 기간 내 로그인 시 탈퇴 취소
+func (service *service) WithdrawUser(ctx context.Context, params WithdrawUserParams) (cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 이미 탈퇴한 회원인 경우
+	if user.DeletedAt.Valid {
+		cErr = errWithdrawnUser
+		return
+	}
+
+	// 비밀번호 검증
+	err = service.passwordHasher.CheckPassword(params.Password, user.HashedPassword)
+	if err != nil {
+		cErr = errWrongPassword
+		return
+	}
+
+	arg := repository.WithdrawUserParams{
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        user.ID,
+	}
+
+	// 삭제 예정 표시
+	err = service.repository.WithdrawUser(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 모든 세션 차단
+	cErr = service.blockUserSessions(ctx, user.ID)
+	if cErr.Err != nil {
+		return
+	}
+
+	log.Info().Int64("user_id", user.ID).Msg("user withdrawn")
+
+	return
+}
+
+type UpdateUserRoleParams struct {
+	dto.UpdateUserRoleRequestPath
+	dto.UpdateUserRoleRequestBody
+}
+
+// 회원 역할 변경 로직 (관리자 전용)
+// 기존 토큰에 이전 역할이 남아있으므로 모든 세션 차단 및 access 토큰 폐기
+func (service *service) UpdateUserRole(ctx context.Context, params UpdateUserRoleParams) (cErr CustomErr) {
+	// 회원 검색
+	user, err := service.repository.GetUserByID(ctx, params.ID)
+	if err != nil {
+		// 해당 id의 회원이 없는 경우
+		if err == sql.ErrNoRows {
+			cErr = errNotFoundUser
+			return
+		}
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 같은 역할인 경우 변경하지 않음
+	if user.Role == repository.UserRole(params.Role) {
+		return
+	}
+
+	arg := repository.UpdateUserRoleParams{
+		Role: repository.UserRole(params.Role),
+		ID:   user.ID,
+	}
+
+	// 역할 변경
+	err = service.repository.UpdateUserRole(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 모든 세션 차단
+	cErr = service.blockUserSessions(ctx, user.ID)
+	if cErr.Err != nil {
+		return
+	}
+
+	log.Info().Int64("user_id", user.ID).Str("role", params.Role).Msg("user role updated")
+
+	return
+}
+
+// 유예 기간이 지난 탈퇴 회원 삭제 로직
+// 회원이 단독으로 소유한 매장과 상품을 함께 삭제하고
+// 회원의 세션, 매장 회원 정보는 ON DELETE CASCADE로 함께 삭제
+func (service *service) PurgeWithdrawnUsers(ctx context.Context) (count int64, cErr CustomErr) {
+	deletedAt := sql.NullTime{Time: time.Now().Add(-service.config.UserWithdrawalGracePeriod), Valid: true}
+
+	count, err := service.repository.PurgeWithdrawnUsersTx(ctx, deletedAt)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	return
+}
+
+// 탈퇴 유예 기간이 지난 회원인지 확인하는 함수
+func (service *service) isPurgeableUser(user repository.User) bool {
+	return user.DeletedAt.Valid && time.Since(user.DeletedAt.Time) >= service.config.UserWithdrawalGracePeriod
+}
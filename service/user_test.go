@@ -0,0 +1,705 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserProfile(t *testing.T) {
+	user, _ := createRandomUser(t)
+	user.DisplayName = sql.NullString{String: util.CreateRandomString(10), Valid: true}
+
+	testCases := []struct {
+		name          string
+		params        GetUserProfileParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetUserProfileResponse, err CustomErr)
+	}{
+		{
+			name:   "성공",
+			params: GetUserProfileParams{UserID: user.ID},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, result.ID, user.ID)
+				require.Equal(t, result.PhoneNumber, user.PhoneNumber)
+				require.Equal(t, result.Role, string(user.Role))
+				require.Equal(t, *result.DisplayName, user.DisplayName.String)
+				require.Nil(t, result.ShopName)
+				require.Equal(t, result.Timezone, user.Timezone)
+			},
+		},
+		{
+			name:   "존재하지 않는 회원",
+			params: GetUserProfileParams{UserID: user.ID},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name:   "Internal Server Error",
+			params: GetUserProfileParams{UserID: user.ID},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.User{}, sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.GetUserProfile(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestUpdateUserProfile(t *testing.T) {
+	user, _ := createRandomUser(t)
+	user.DisplayName = sql.NullString{String: util.CreateRandomString(10), Valid: true}
+
+	displayName := util.CreateRandomString(10)
+	shopName := util.CreateRandomString(10)
+	timezone := "America/New_York"
+	empty := ""
+
+	newPhoneNumber := util.CreateRandomPhoneNumber()
+	verification, code := createRandomVerification(t, newPhoneNumber, repository.VerificationPurposeChangePhoneNumber)
+	wrongCode := createWrongVerificationCode(code)
+
+	testCases := []struct {
+		name          string
+		params        UpdateUserProfileParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(result dto.GetUserProfileResponse, err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					ShopName: &shopName,
+					Timezone: &timezone,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.UpdateUserProfileParams{
+					PhoneNumber: user.PhoneNumber,
+					DisplayName: user.DisplayName,
+					ShopName:    sql.NullString{String: shopName, Valid: true},
+					Timezone:    timezone,
+					ID:          user.ID,
+				}
+
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, *result.DisplayName, user.DisplayName.String)
+				require.Equal(t, *result.ShopName, shopName)
+				require.Equal(t, result.Timezone, timezone)
+			},
+		},
+		{
+			name: "빈 문자열 입력 시 삭제",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					DisplayName: &empty,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.UpdateUserProfileParams{
+					PhoneNumber: user.PhoneNumber,
+					DisplayName: sql.NullString{},
+					ShopName:    user.ShopName,
+					Timezone:    user.Timezone,
+					ID:          user.ID,
+				}
+
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Nil(t, result.DisplayName)
+			},
+		},
+		{
+			name: "휴대폰 번호 변경 성공",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					DisplayName:      &displayName,
+					PhoneNumber:      &newPhoneNumber,
+					VerificationCode: &code,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				arg := repository.UpdateUserProfileParams{
+					PhoneNumber: newPhoneNumber,
+					DisplayName: sql.NullString{String: displayName, Valid: true},
+					ShopName:    user.ShopName,
+					Timezone:    user.Timezone,
+					ID:          user.ID,
+				}
+
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Eq(repository.GetLatestVerificationParams{
+						PhoneNumber: newPhoneNumber,
+						Purpose:     repository.VerificationPurposeChangePhoneNumber,
+					})).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, result.PhoneNumber, newPhoneNumber)
+				require.Equal(t, *result.DisplayName, displayName)
+			},
+		},
+		{
+			name: "같은 휴대폰 번호 입력 시 인증 생략",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					PhoneNumber:      &user.PhoneNumber,
+					VerificationCode: &wrongCode,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, result.PhoneNumber, user.PhoneNumber)
+			},
+		},
+		{
+			name: "휴대폰 번호 인증번호 불일치",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					PhoneNumber:      &newPhoneNumber,
+					VerificationCode: &wrongCode,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					IncreaseVerificationAttempt(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errWrongVerificationCode)
+			},
+		},
+		{
+			name: "중복된 휴대폰 번호",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					PhoneNumber:      &newPhoneNumber,
+					VerificationCode: &code,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&mysql.MySQLError{Number: repository.DB_DUPLICATE_ERROR, Message: "Duplicate entry for key 'user.phone_number'"})
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errDuplicatePhoneNumber)
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					ShopName: &shopName,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: UpdateUserProfileParams{
+				UserID: user.ID,
+				UpdateUserProfileRequestBody: dto.UpdateUserProfileRequestBody{
+					ShopName: &shopName,
+				},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(result dto.GetUserProfileResponse, err CustomErr) {
+				require.Empty(t, result)
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			result, err := service.UpdateUserProfile(context.Background(), tc.params)
+			tc.checkResponse(result, err)
+		})
+	}
+}
+
+func TestWithdrawUser(t *testing.T) {
+	user, password := createRandomUser(t)
+
+	withdrawnUser := user
+	withdrawnUser.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		params        WithdrawUserParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: WithdrawUserParams{
+				UserID:                  user.ID,
+				WithdrawUserRequestBody: dto.WithdrawUserRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					WithdrawUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg repository.WithdrawUserParams) error {
+						require.Equal(t, arg.ID, user.ID)
+						require.True(t, arg.DeletedAt.Valid)
+						require.WithinDuration(t, arg.DeletedAt.Time, time.Now(), time.Second)
+						return nil
+					})
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			params: WithdrawUserParams{
+				UserID:                  user.ID,
+				WithdrawUserRequestBody: dto.WithdrawUserRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					WithdrawUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "이미 탈퇴한 회원",
+			params: WithdrawUserParams{
+				UserID:                  user.ID,
+				WithdrawUserRequestBody: dto.WithdrawUserRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(withdrawnUser, nil)
+				mockRepository.EXPECT().
+					WithdrawUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWithdrawnUser)
+			},
+		},
+		{
+			name: "비밀번호가 틀린 경우",
+			params: WithdrawUserParams{
+				UserID:                  user.ID,
+				WithdrawUserRequestBody: dto.WithdrawUserRequestBody{Password: util.CreateRandomString(10)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					WithdrawUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongPassword)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: WithdrawUserParams{
+				UserID:                  user.ID,
+				WithdrawUserRequestBody: dto.WithdrawUserRequestBody{Password: password},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					WithdrawUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.WithdrawUser(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	user, _ := createRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		params        UpdateUserRoleParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			params: UpdateUserRoleParams{
+				UpdateUserRoleRequestPath: dto.UpdateUserRoleRequestPath{ID: user.ID},
+				UpdateUserRoleRequestBody: dto.UpdateUserRoleRequestBody{Role: string(repository.UserRoleStaff)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Eq(repository.UpdateUserRoleParams{
+						Role: repository.UserRoleStaff,
+						ID:   user.ID,
+					})).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					GetRecentSessionList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]repository.Session{}, nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "같은 역할인 경우",
+			params: UpdateUserRoleParams{
+				UpdateUserRoleRequestPath: dto.UpdateUserRoleRequestPath{ID: user.ID},
+				UpdateUserRoleRequestBody: dto.UpdateUserRoleRequestBody{Role: string(user.Role)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "존재하지 않는 회원",
+			params: UpdateUserRoleParams{
+				UpdateUserRoleRequestPath: dto.UpdateUserRoleRequestPath{ID: user.ID},
+				UpdateUserRoleRequestBody: dto.UpdateUserRoleRequestBody{Role: string(repository.UserRoleStaff)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(repository.User{}, sql.ErrNoRows)
+				mockRepository.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundUser)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: UpdateUserRoleParams{
+				UpdateUserRoleRequestPath: dto.UpdateUserRoleRequestPath{ID: user.ID},
+				UpdateUserRoleRequestBody: dto.UpdateUserRoleRequestBody{Role: string(repository.UserRoleStaff)},
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				mockRepository.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				mockRepository.EXPECT().
+					BlockUserSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			err := service.UpdateUserRole(context.Background(), tc.params)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func TestPurgeWithdrawnUsers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(count int64, err CustomErr)
+	}{
+		{
+			name: "성공",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					PurgeWithdrawnUsersTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, deletedAt sql.NullTime) (int64, error) {
+						require.True(t, deletedAt.Valid)
+						require.WithinDuration(t, deletedAt.Time, time.Now().Add(-testConfig.UserWithdrawalGracePeriod), time.Second)
+						return 3, nil
+					})
+			},
+			checkResponse: func(count int64, err CustomErr) {
+				require.Empty(t, err)
+				require.Equal(t, count, int64(3))
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					PurgeWithdrawnUsersTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(count int64, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+				require.Zero(t, count)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repository := mockrepository.NewMockRepository(ctrl)
+			service := newTestService(t, repository)
+
+			tc.buildStubs(repository)
+
+			count, err := service.PurgeWithdrawnUsers(context.Background())
+			tc.checkResponse(count, err)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gitaepark/pha/dto"
+	"github.com/gitaepark/pha/repository"
+	"github.com/rs/zerolog/log"
+)
+
+const verificationCodeLength = 6
+
+type SendVerificationCodeParams = dto.SendVerificationCodeRequestBody
+
+// 인증번호 발송 로직
+func (service *service) SendVerificationCode(ctx context.Context, params SendVerificationCodeParams) (cErr CustomErr) {
+	purpose := repository.VerificationPurpose(params.Purpose)
+
+	// 가입 여부는 응답에 노출하지 않음 (휴대폰 번호 가입 여부 조회 방지)
+	// 중복 가입, 미가입 회원은 인증번호 확인 단계(회원가입, 휴대폰 번호 변경, 비밀번호 재설정)에서 처리
+
+	// 재발송 대기 시간 검증
+	verification, err := service.repository.GetLatestVerification(ctx, repository.GetLatestVerificationParams{
+		PhoneNumber: params.PhoneNumber,
+		Purpose:     purpose,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+	if err == nil {
+		// 발송 시각은 서버에서 계산한 만료 시각 기준으로 역산 (DB 시간대 영향 방지)
+		sentAt := verification.ExpiredAt.Add(-service.config.VerificationCodeDuration)
+		if retryAfter := time.Until(sentAt.Add(service.config.VerificationResendInterval)); retryAfter > 0 {
+			cErr = errTooManyVerificationRequests(retryAfter)
+			return
+		}
+	}
+
+	// 인증번호 생성
+	code, err := generateVerificationCode()
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 인증번호 암호화
+	hashedCode, err := service.passwordHasher.HashPassword(code)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	arg := repository.CreateVerificationParams{
+		PhoneNumber: params.PhoneNumber,
+		Purpose:     purpose,
+		HashedCode:  hashedCode,
+		ExpiredAt:   time.Now().Add(service.config.VerificationCodeDuration),
+	}
+
+	// 인증번호 저장
+	err = service.repository.CreateVerification(ctx, arg)
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	// 인증번호 발송
+	err = service.smsSender.Send(ctx, params.PhoneNumber, fmt.Sprintf("[pha] 인증번호는 [%s] 입니다.", code))
+	if err != nil {
+		cErr = NewErrInternalServer(err)
+		return
+	}
+
+	return
+}
+
+// 숫자 인증번호 생성 함수
+func generateVerificationCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < verificationCodeLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", verificationCodeLength, n), nil
+}
+
+// 인증번호 검증 및 사용 처리
+func (service *service) verifyCode(ctx context.Context, phoneNumber string, purpose repository.VerificationPurpose, code string) CustomErr {
+	// 마지막으로 발송한 인증번호 검색
+	verification, err := service.repository.GetLatestVerification(ctx, repository.GetLatestVerificationParams{
+		PhoneNumber: phoneNumber,
+		Purpose:     purpose,
+	})
+	if err != nil {
+		// 인증번호를 요청하지 않은 경우
+		if err == sql.ErrNoRows {
+			return errNotFoundVerification
+		}
+
+		return NewErrInternalServer(err)
+	}
+
+	// 인증번호 검증
+	// 이미 사용한 인증번호인 경우
+	if verification.IsUsed {
+		return errUsedVerification
+	}
+	// 인증번호가 만료된 경우
+	if time.Now().After(verification.ExpiredAt) {
+		return errExpiredVerification
+	}
+	// 입력 횟수를 초과한 경우
+	if service.config.VerificationMaxAttempts > 0 && int(verification.AttemptCount) >= service.config.VerificationMaxAttempts {
+		return errTooManyVerificationAttempts
+	}
+	// 인증번호가 일치하지 않는 경우
+	err = service.passwordHasher.CheckPassword(code, verification.HashedCode)
+	if err != nil {
+		err = service.repository.IncreaseVerificationAttempt(ctx, verification.ID)
+		if err != nil {
+			return NewErrInternalServer(err)
+		}
+
+		log.Info().Str("phone_number", phoneNumber).Str("purpose", string(purpose)).Msg("wrong verification code")
+
+		return errWrongVerificationCode
+	}
+
+	// 인증번호 사용 처리
+	rows, err := service.repository.UseVerification(ctx, verification.ID)
+	if err != nil {
+		return NewErrInternalServer(err)
+	}
+	// 동시에 같은 인증번호를 사용한 경우
+	if rows == 0 {
+		return errUsedVerification
+	}
+
+	return CustomErr{}
+}
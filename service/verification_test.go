@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+	mockrepository "github.com/gitaepark/pha/repository/mock"
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/lockout"
+	"github.com/gitaepark/pha/util/notifier"
+	"github.com/gitaepark/pha/util/revocation"
+	"github.com/gitaepark/pha/util/sms"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// 발송한 문자를 기록하는 테스트용 문자 발송기
+type recordSMSSender struct {
+	mu       sync.Mutex
+	messages map[string]string
+}
+
+func (sender *recordSMSSender) Send(ctx context.Context, phoneNumber string, message string) error {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	sender.messages[phoneNumber] = message
+
+	return nil
+}
+
+func TestSendVerificationCode(t *testing.T) {
+	user, _ := createRandomUser(t)
+	verification, _ := createRandomVerification(t, user.PhoneNumber, repository.VerificationPurposeRegister)
+
+	config := testConfig
+	config.VerificationCodeDuration = 3 * time.Minute
+	config.VerificationResendInterval = time.Minute
+
+	testCases := []struct {
+		name          string
+		params        SendVerificationCodeParams
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(sender *recordSMSSender, hashedCode string, err CustomErr)
+	}{
+		{
+			name: "회원가입 성공",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposeRegister),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Empty(t, err)
+
+				code := regexp.MustCompile(`[0-9]{6}`).FindString(sender.messages[user.PhoneNumber])
+				require.NotEmpty(t, code)
+				require.NoError(t, testPasswordHasher.CheckPassword(code, hashedCode))
+			},
+		},
+		{
+			name: "비밀번호 재설정 성공",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposePasswordReset),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, sender.messages[user.PhoneNumber])
+			},
+		},
+		{
+			name: "가입한 휴대폰 번호",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposeRegister),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				// 가입 여부와 관계없이 같은 응답
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, sender.messages[user.PhoneNumber])
+			},
+		},
+		{
+			name: "휴대폰 번호 변경 시 가입한 휴대폰 번호",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposeChangePhoneNumber),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				// 가입 여부와 관계없이 같은 응답
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, sender.messages[user.PhoneNumber])
+			},
+		},
+		{
+			name: "가입하지 않은 휴대폰 번호",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposePasswordReset),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				// 가입 여부와 관계없이 같은 응답
+				mockRepository.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Empty(t, err)
+				require.NotEmpty(t, sender.messages[user.PhoneNumber])
+			},
+		},
+		{
+			name: "재발송 대기",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposeRegister),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Equal(t, err.Code, http.StatusTooManyRequests)
+				require.InDelta(t, time.Minute, err.RetryAfter, float64(time.Second))
+				require.Empty(t, sender.messages)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			params: SendVerificationCodeParams{
+				PhoneNumber: user.PhoneNumber,
+				Purpose:     string(repository.VerificationPurposeRegister),
+			},
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrConnDone)
+			},
+			checkResponse: func(sender *recordSMSSender, hashedCode string, err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			sender := &recordSMSSender{messages: map[string]string{}}
+			service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sender, notifier.NewLogNotifier(), testCursorSigner, mockRepository)
+
+			tc.buildStubs(mockRepository)
+
+			var hashedCode string
+			mockRepository.EXPECT().
+				CreateVerification(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				DoAndReturn(func(ctx context.Context, arg repository.CreateVerificationParams) error {
+					require.Equal(t, arg.PhoneNumber, tc.params.PhoneNumber)
+					require.Equal(t, string(arg.Purpose), tc.params.Purpose)
+					require.WithinDuration(t, arg.ExpiredAt, time.Now().Add(config.VerificationCodeDuration), time.Second)
+					hashedCode = arg.HashedCode
+					return nil
+				})
+
+			err := service.SendVerificationCode(context.Background(), tc.params)
+			tc.checkResponse(sender, hashedCode, err)
+		})
+	}
+}
+
+func TestVerifyCode(t *testing.T) {
+	phoneNumber := util.CreateRandomPhoneNumber()
+	verification, code := createRandomVerification(t, phoneNumber, repository.VerificationPurposeRegister)
+
+	config := testConfig
+	config.VerificationMaxAttempts = 5
+
+	testCases := []struct {
+		name          string
+		code          string
+		buildStubs    func(mockRepository *mockrepository.MockRepository)
+		checkResponse func(err CustomErr)
+	}{
+		{
+			name: "성공",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Eq(repository.GetLatestVerificationParams{
+						PhoneNumber: phoneNumber,
+						Purpose:     repository.VerificationPurposeRegister,
+					})).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Empty(t, err)
+			},
+		},
+		{
+			name: "요청하지 않은 인증번호",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errNotFoundVerification)
+			},
+		},
+		{
+			name: "사용한 인증번호",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				usedVerification := verification
+				usedVerification.IsUsed = true
+
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(usedVerification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errUsedVerification)
+			},
+		},
+		{
+			name: "만료된 인증번호",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				expiredVerification := verification
+				expiredVerification.ExpiredAt = time.Now().Add(-time.Minute)
+
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(expiredVerification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errExpiredVerification)
+			},
+		},
+		{
+			name: "입력 횟수 초과",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				attemptedVerification := verification
+				attemptedVerification.AttemptCount = int32(config.VerificationMaxAttempts)
+
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(attemptedVerification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errTooManyVerificationAttempts)
+			},
+		},
+		{
+			name: "인증번호 불일치",
+			code: createWrongVerificationCode(code),
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					IncreaseVerificationAttempt(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errWrongVerificationCode)
+			},
+		},
+		{
+			name: "동시 사용",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(verification, nil)
+				mockRepository.EXPECT().
+					UseVerification(gomock.Any(), gomock.Eq(verification.ID)).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, errUsedVerification)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			code: code,
+			buildStubs: func(mockRepository *mockrepository.MockRepository) {
+				mockRepository.EXPECT().
+					GetLatestVerification(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(repository.Verification{}, sql.ErrConnDone)
+			},
+			checkResponse: func(err CustomErr) {
+				require.Equal(t, err, NewErrInternalServer(sql.ErrConnDone))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepository := mockrepository.NewMockRepository(ctrl)
+			service := NewService(config, testTokenMaker, testPasswordHasher, lockout.NewMemoryStore(), revocation.NewMemoryStore(), sms.NewConsoleSender(), notifier.NewLogNotifier(), testCursorSigner, mockRepository).(*service)
+
+			tc.buildStubs(mockRepository)
+
+			err := service.verifyCode(context.Background(), phoneNumber, repository.VerificationPurposeRegister, tc.code)
+			tc.checkResponse(err)
+		})
+	}
+}
+
+func createRandomVerification(t *testing.T, phoneNumber string, purpose repository.VerificationPurpose) (repository.Verification, string) {
+	code, err := generateVerificationCode()
+	require.NoError(t, err)
+	hashedCode, err := testPasswordHasher.HashPassword(code)
+	require.NoError(t, err)
+
+	verification := repository.Verification{
+		ID:           util.CreateRandomInt64(1, 10),
+		PhoneNumber:  phoneNumber,
+		Purpose:      purpose,
+		HashedCode:   hashedCode,
+		AttemptCount: 0,
+		IsUsed:       false,
+		ExpiredAt:    time.Now().Add(3 * time.Minute),
+		CreatedAt:    time.Now(),
+	}
+
+	return verification, code
+}
+
+// 일치하지 않는 인증번호 생성 함수
+func createWrongVerificationCode(code string) string {
+	n, _ := strconv.Atoi(code)
+
+	return fmt.Sprintf("%06d", (n+1)%1000000)
+}
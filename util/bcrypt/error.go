@@ -1,7 +0,0 @@
-package bcrypt
-
-import "fmt"
-
-func errHashPassword(err error) error {
-	return fmt.Errorf("failed to hash password: %w", err)
-}
@@ -11,14 +11,55 @@ const (
 )
 
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	JWTSecret            string        `mapstructure:"JWT_SECRET"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	Environment                string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                   string        `mapstructure:"DB_DRIVER"`
+	DBSource                   string        `mapstructure:"DB_SOURCE"`
+	MigrationURL               string        `mapstructure:"MIGRATION_URL"`
+	HTTPServerAddress          string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	TokenType                  string        `mapstructure:"TOKEN_TYPE"`
+	TokenSymmetricKey          string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	JWTAlgorithm               string        `mapstructure:"JWT_ALGORITHM"`
+	JWTKeyID                   string        `mapstructure:"JWT_KEY_ID"`
+	JWTSecret                  string        `mapstructure:"JWT_SECRET"`
+	JWTPrivateKeyPath          string        `mapstructure:"JWT_PRIVATE_KEY_PATH"`
+	JWTPublicKeyPaths          string        `mapstructure:"JWT_PUBLIC_KEY_PATHS"`
+	LoginMaxAttempts           int           `mapstructure:"LOGIN_MAX_ATTEMPTS"`
+	LoginMaxAttemptsPerIp      int           `mapstructure:"LOGIN_MAX_ATTEMPTS_PER_IP"`
+	LoginLockDuration          time.Duration `mapstructure:"LOGIN_LOCK_DURATION"`
+	LoginMaxLockDuration       time.Duration `mapstructure:"LOGIN_MAX_LOCK_DURATION"`
+	PasswordMinLength          int           `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMinCharClasses     int           `mapstructure:"PASSWORD_MIN_CHAR_CLASSES"`
+	PasswordHashAlgorithm      string        `mapstructure:"PASSWORD_HASH_ALGORITHM"`
+	BcryptCost                 int           `mapstructure:"BCRYPT_COST"`
+	Argon2Memory               uint32        `mapstructure:"ARGON2_MEMORY"`
+	Argon2Iterations           uint32        `mapstructure:"ARGON2_ITERATIONS"`
+	Argon2Parallelism          uint8         `mapstructure:"ARGON2_PARALLELISM"`
+	SMSSender                  string        `mapstructure:"SMS_SENDER"`
+	SMSFilePath                string        `mapstructure:"SMS_FILE_PATH"`
+	Notifier                   string        `mapstructure:"NOTIFIER"`
+	NotifierFilePath           string        `mapstructure:"NOTIFIER_FILE_PATH"`
+	VerificationCodeDuration   time.Duration `mapstructure:"VERIFICATION_CODE_DURATION"`
+	VerificationMaxAttempts    int           `mapstructure:"VERIFICATION_MAX_ATTEMPTS"`
+	VerificationResendInterval time.Duration `mapstructure:"VERIFICATION_RESEND_INTERVAL"`
+	UserWithdrawalGracePeriod  time.Duration `mapstructure:"USER_WITHDRAWAL_GRACE_PERIOD"`
+	UserPurgeInterval          time.Duration `mapstructure:"USER_PURGE_INTERVAL"`
+	SessionPurgeInterval       time.Duration `mapstructure:"SESSION_PURGE_INTERVAL"`
+	SessionRetentionPeriod     time.Duration `mapstructure:"SESSION_RETENTION_PERIOD"`
+	SessionMaxActive           int           `mapstructure:"SESSION_MAX_ACTIVE"`
+	SessionLimitPolicy         string        `mapstructure:"SESSION_LIMIT_POLICY"`
+	ShutdownTimeout            time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	TOTPIssuer                 string        `mapstructure:"TOTP_ISSUER"`
+	TwoFactorTokenDuration     time.Duration `mapstructure:"TWO_FACTOR_TOKEN_DURATION"`
+	StoreInvitationDuration    time.Duration `mapstructure:"STORE_INVITATION_DURATION"`
+	TokenRevocationStore       string        `mapstructure:"TOKEN_REVOCATION_STORE"`
+	RevokedTokenPurgeInterval  time.Duration `mapstructure:"REVOKED_TOKEN_PURGE_INTERVAL"`
+	AccessTokenDuration        time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration       time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	RefreshTokenBinding        string        `mapstructure:"REFRESH_TOKEN_BINDING"`
+	IntrospectionClientID      string        `mapstructure:"INTROSPECTION_CLIENT_ID"`
+	IntrospectionClientSecret  string        `mapstructure:"INTROSPECTION_CLIENT_SECRET"`
+	CursorSecret               string        `mapstructure:"CURSOR_SECRET"`
+	PageMaxSize                int32         `mapstructure:"PAGE_MAX_SIZE"`
 }
 
 // config 조회 함수
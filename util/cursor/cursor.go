@@ -0,0 +1,91 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 서명 키 최소 길이
+const minKeySize = 32
+
+var (
+	ErrInvalidCursor  = fmt.Errorf("invalid cursor")
+	ErrInvalidKeySize = fmt.Errorf("invalid key size: must be at least %d characters", minKeySize)
+)
+
+// 목록 조회 기준 위치 (마지막으로 조회한 항목의 생성 시각, id)
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// 커서 서명기
+// 클라이언트가 커서 값을 조작하지 못하도록 HMAC-SHA256 서명 추가
+type Signer struct {
+	key []byte
+}
+
+// 커서 서명기 생성 함수
+func NewSigner(key string) (*Signer, error) {
+	// 빈 키나 짧은 키로 서명하면 커서 위조 가능
+	if len(key) < minKeySize {
+		return nil, ErrInvalidKeySize
+	}
+
+	return &Signer{key: []byte(key)}, nil
+}
+
+// 커서 문자열 생성 함수 (base64url(생성 시각.id).base64url(서명))
+func (signer *Signer) Encode(cursor Cursor) string {
+	payload := []byte(fmt.Sprintf("%d.%d", cursor.CreatedAt.UnixNano(), cursor.ID))
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signer.sign(payload))
+}
+
+// 커서 문자열 검증 및 해석 함수
+func (signer *Signer) Decode(value string) (Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(value, ".")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if !hmac.Equal(signature, signer.sign(payload)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(payload), ".")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	unixNano, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	cursorID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{CreatedAt: time.Unix(0, unixNano), ID: cursorID}, nil
+}
+
+func (signer *Signer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, signer.key)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,57 @@
+package cursor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner(util.CreateRandomString(32))
+	require.NoError(t, err)
+
+	cursor := Cursor{
+		CreatedAt: time.Now().Truncate(time.Second),
+		ID:        util.CreateRandomInt64(1, 1000),
+	}
+
+	value := signer.Encode(cursor)
+
+	decoded, err := signer.Decode(value)
+	require.NoError(t, err)
+	require.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	require.Equal(t, cursor.ID, decoded.ID)
+
+	// 다른 키로 서명된 커서
+	otherSigner, err := NewSigner(util.CreateRandomString(32))
+	require.NoError(t, err)
+	_, err = otherSigner.Decode(value)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	// 조작된 커서
+	encodedPayload, encodedSignature, _ := strings.Cut(value, ".")
+	forged := signer.Encode(Cursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID + 1})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	require.NotEqual(t, encodedPayload, forgedPayload)
+
+	_, err = signer.Decode(forgedPayload + "." + encodedSignature)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	// 형식이 잘못된 커서
+	for _, invalid := range []string{"", util.CreateRandomString(20), "!!!.!!!"} {
+		_, err = signer.Decode(invalid)
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	}
+}
+
+func TestNewSignerInvalidKeySize(t *testing.T) {
+	// 빈 키, 최소 길이보다 짧은 키
+	for _, key := range []string{"", strings.Repeat("a", minKeySize-1)} {
+		signer, err := NewSigner(key)
+		require.ErrorIs(t, err, ErrInvalidKeySize)
+		require.Nil(t, signer)
+	}
+}
@@ -0,0 +1,77 @@
+package device
+
+import (
+	"strings"
+
+	"github.com/mssola/useragent"
+)
+
+const (
+	TypeDesktop = "desktop"
+	TypeMobile  = "mobile"
+	TypeTablet  = "tablet"
+	TypeBot     = "bot"
+)
+
+type Device struct {
+	Type    string `json:"type"`
+	OS      string `json:"os"`
+	Browser string `json:"browser"`
+}
+
+// user agent 기기 정보 파싱 함수
+func Parse(userAgent string) Device {
+	ua := useragent.New(userAgent)
+
+	osInfo := parseOSInfo(ua)
+	browserName, browserVersion := ua.Browser()
+
+	return Device{
+		Type:    parseType(ua),
+		OS:      strings.TrimSpace(osInfo.Name + " " + osInfo.Version),
+		Browser: strings.TrimSpace(browserName + " " + majorVersion(browserVersion)),
+	}
+}
+
+// user agent 기기 식별값 생성 함수
+// OS, 브라우저 업데이트로 새 기기로 판별되지 않도록 버전은 제외
+func Fingerprint(userAgent string) string {
+	ua := useragent.New(userAgent)
+
+	osInfo := parseOSInfo(ua)
+	browserName, _ := ua.Browser()
+
+	return strings.Join([]string{parseType(ua), osInfo.Name, browserName}, "/")
+}
+
+// OS 정보 파싱 함수
+func parseOSInfo(ua *useragent.UserAgent) useragent.OSInfo {
+	osInfo := ua.OSInfo()
+	// iPad user agent는 OS 이름이 "OS"로만 표기됨
+	if osInfo.Name == "OS" && ua.Platform() == "iPad" {
+		osInfo.Name = "iPadOS"
+	}
+
+	return osInfo
+}
+
+// 기기 종류 판별 함수
+func parseType(ua *useragent.UserAgent) string {
+	switch {
+	case ua.Bot():
+		return TypeBot
+	case ua.Platform() == "iPad" || strings.Contains(ua.UA(), "Tablet"):
+		return TypeTablet
+	case ua.Mobile():
+		return TypeMobile
+	default:
+		return TypeDesktop
+	}
+}
+
+// 버전 문자열의 major 버전 추출 함수
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+
+	return major
+}
@@ -0,0 +1,55 @@
+package device
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		userAgent string
+		device    Device
+	}{
+		{
+			name:      "desktop",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36",
+			device:    Device{Type: TypeDesktop, OS: "Windows 10", Browser: "Chrome 118"},
+		},
+		{
+			name:      "mobile",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+			device:    Device{Type: TypeMobile, OS: "iPhone OS 17.0", Browser: "Safari 17"},
+		},
+		{
+			name:      "tablet",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.6 Mobile/15E148 Safari/604.1",
+			device:    Device{Type: TypeTablet, OS: "iPadOS 16.6", Browser: "Safari 16"},
+		},
+		{
+			name:      "bot",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			device:    Device{Type: TypeBot, OS: "", Browser: "Googlebot 2"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.device, Parse(tc.userAgent))
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	chrome118 := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	chrome119 := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36"
+	iPhone := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+
+	require.Equal(t, "desktop/Windows/Chrome", Fingerprint(chrome118))
+	// 브라우저 업데이트는 같은 기기로 판별
+	require.Equal(t, Fingerprint(chrome118), Fingerprint(chrome119))
+	require.NotEqual(t, Fingerprint(chrome118), Fingerprint(iPhone))
+}
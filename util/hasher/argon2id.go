@@ -0,0 +1,129 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix = "$argon2id$"
+
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 2
+	argon2SaltLength         = 16
+	argon2KeyLength          = 32
+)
+
+type Argon2idParams struct {
+	// 메모리 사용량 (KiB)
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+type Argon2idHasher struct {
+	params Argon2idParams
+}
+
+// 0인 파라미터는 기본값 사용
+func NewArgon2idHasher(params Argon2idParams) *Argon2idHasher {
+	if params.Memory == 0 {
+		params.Memory = defaultArgon2Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = defaultArgon2Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = defaultArgon2Parallelism
+	}
+
+	return &Argon2idHasher{params: params}
+}
+
+// PHC 문자열 형식($argon2id$v=19$m=65536,t=3,p=2$salt$hash)으로 암호화
+func (hasher *Argon2idHasher) HashPassword(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errHashPassword(err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, hasher.params.Iterations, hasher.params.Memory, hasher.params.Parallelism, argon2KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		hasher.params.Memory,
+		hasher.params.Iterations,
+		hasher.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (hasher *Argon2idHasher) CheckPassword(password, hashedPassword string) error {
+	params, salt, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+
+	return nil
+}
+
+func (hasher *Argon2idHasher) NeedsRehash(hashedPassword string) bool {
+	params, salt, key, err := decodeArgon2idHash(hashedPassword)
+	if err != nil {
+		return true
+	}
+
+	return params != hasher.params || len(salt) != argon2SaltLength || len(key) != argon2KeyLength
+}
+
+func (hasher *Argon2idHasher) Identify(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, argon2idPrefix)
+}
+
+// PHC 문자열에서 파라미터, salt, 해시 추출 함수
+func decodeArgon2idHash(hashedPassword string) (params Argon2idParams, salt, key []byte, err error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		err = ErrInvalidHash
+		return
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		err = ErrInvalidHash
+		return
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		err = ErrInvalidHash
+		return
+	}
+
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		err = ErrInvalidHash
+		return
+	}
+
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		err = ErrInvalidHash
+		return
+	}
+
+	return
+}
@@ -0,0 +1,51 @@
+package hasher
+
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptHasher struct {
+	cost int
+}
+
+// cost가 0 이하인 경우 기본값 사용
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost <= 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	return &BcryptHasher{cost: cost}
+}
+
+func (hasher *BcryptHasher) HashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), hasher.cost)
+	if err != nil {
+		return "", errHashPassword(err)
+	}
+
+	return string(hashedPassword), nil
+}
+
+func (hasher *BcryptHasher) CheckPassword(password, hashedPassword string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return ErrMismatchedHashAndPassword
+	}
+
+	return err
+}
+
+func (hasher *BcryptHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	if err != nil {
+		return true
+	}
+
+	return cost != hasher.cost
+}
+
+func (hasher *BcryptHasher) Identify(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, "$2a$") || strings.HasPrefix(hashedPassword, "$2b$") || strings.HasPrefix(hashedPassword, "$2y$")
+}
@@ -0,0 +1,13 @@
+package hasher
+
+import "fmt"
+
+var (
+	ErrMismatchedHashAndPassword = fmt.Errorf("hashed password is not the hash of the given password")
+	ErrInvalidHash               = fmt.Errorf("hashed password is invalid")
+	ErrUnsupportedAlgorithm      = fmt.Errorf("unsupported password hash algorithm")
+)
+
+func errHashPassword(err error) error {
+	return fmt.Errorf("failed to hash password: %w", err)
+}
@@ -0,0 +1,78 @@
+package hasher
+
+import (
+	"github.com/gitaepark/pha/util"
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+type PasswordHasher interface {
+	// 비밀번호 암호화 함수 (알고리즘, 파라미터를 해시 문자열에 포함)
+	HashPassword(password string) (string, error)
+	// 비밀번호 검증 함수
+	CheckPassword(password, hashedPassword string) error
+	// 현재 알고리즘, 파라미터와 다른 해시인지 확인하는 함수
+	NeedsRehash(hashedPassword string) bool
+}
+
+// 알고리즘별 해시 구현체
+type Algorithm interface {
+	PasswordHasher
+	// 해당 알고리즘으로 생성한 해시인지 확인하는 함수
+	Identify(hashedPassword string) bool
+}
+
+// config 기반 비밀번호 해시 생성기 생성 함수
+func NewPasswordHasher(config util.Config) (PasswordHasher, error) {
+	switch config.PasswordHashAlgorithm {
+	case "", AlgorithmArgon2id:
+		return NewHasher(NewArgon2idHasher(Argon2idParams{
+			Memory:      config.Argon2Memory,
+			Iterations:  config.Argon2Iterations,
+			Parallelism: config.Argon2Parallelism,
+		})), nil
+	case AlgorithmBcrypt:
+		return NewHasher(NewBcryptHasher(config.BcryptCost)), nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// 현재 알고리즘으로 암호화하고, 지원하는 모든 알고리즘의 해시를 검증하는 해시 생성기
+type Hasher struct {
+	current    Algorithm
+	algorithms []Algorithm
+}
+
+func NewHasher(current Algorithm) *Hasher {
+	return &Hasher{
+		current: current,
+		// 검증에만 사용하므로 기본 파라미터로 생성
+		algorithms: []Algorithm{current, NewArgon2idHasher(Argon2idParams{}), NewBcryptHasher(0)},
+	}
+}
+
+func (hasher *Hasher) HashPassword(password string) (string, error) {
+	return hasher.current.HashPassword(password)
+}
+
+func (hasher *Hasher) CheckPassword(password, hashedPassword string) error {
+	for _, algorithm := range hasher.algorithms {
+		if algorithm.Identify(hashedPassword) {
+			return algorithm.CheckPassword(password, hashedPassword)
+		}
+	}
+
+	return ErrInvalidHash
+}
+
+func (hasher *Hasher) NeedsRehash(hashedPassword string) bool {
+	if !hasher.current.Identify(hashedPassword) {
+		return true
+	}
+
+	return hasher.current.NeedsRehash(hashedPassword)
+}
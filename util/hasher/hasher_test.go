@@ -0,0 +1,149 @@
+package hasher
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 테스트 속도를 위해 낮은 파라미터 사용
+var testArgon2idParams = Argon2idParams{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestPassword(t *testing.T) {
+	testCases := []struct {
+		name   string
+		hasher Algorithm
+		prefix string
+	}{
+		{
+			name:   "argon2id",
+			hasher: NewArgon2idHasher(testArgon2idParams),
+			prefix: "$argon2id$v=19$m=1024,t=1,p=1$",
+		},
+		{
+			name:   "bcrypt",
+			hasher: NewBcryptHasher(bcrypt.MinCost),
+			prefix: "$2a$04$",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			password := util.CreateRandomString(10)
+
+			hashedPassword1, err := tc.hasher.HashPassword(password)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(hashedPassword1, tc.prefix))
+			require.True(t, tc.hasher.Identify(hashedPassword1))
+			require.False(t, tc.hasher.NeedsRehash(hashedPassword1))
+
+			err = tc.hasher.CheckPassword(password, hashedPassword1)
+			require.NoError(t, err)
+
+			wrongPassword := util.CreateRandomString(10)
+			err = tc.hasher.CheckPassword(wrongPassword, hashedPassword1)
+			require.ErrorIs(t, err, ErrMismatchedHashAndPassword)
+
+			hashedPassword2, _ := tc.hasher.HashPassword(password)
+			require.NotEqual(t, hashedPassword1, hashedPassword2)
+		})
+	}
+}
+
+func TestHasher(t *testing.T) {
+	password := util.CreateRandomString(10)
+
+	bcryptHash, err := NewBcryptHasher(bcrypt.MinCost).HashPassword(password)
+	require.NoError(t, err)
+	argon2idHash, err := NewArgon2idHasher(testArgon2idParams).HashPassword(password)
+	require.NoError(t, err)
+
+	hasher := NewHasher(NewArgon2idHasher(testArgon2idParams))
+
+	// 지원하는 모든 알고리즘의 해시 검증
+	require.NoError(t, hasher.CheckPassword(password, bcryptHash))
+	require.NoError(t, hasher.CheckPassword(password, argon2idHash))
+	require.ErrorIs(t, hasher.CheckPassword(util.CreateRandomString(10), bcryptHash), ErrMismatchedHashAndPassword)
+	require.ErrorIs(t, hasher.CheckPassword(password, util.CreateRandomString(10)), ErrInvalidHash)
+
+	// 다른 알고리즘의 해시는 재암호화 필요
+	require.True(t, hasher.NeedsRehash(bcryptHash))
+	require.False(t, hasher.NeedsRehash(argon2idHash))
+
+	// 파라미터가 바뀐 경우 재암호화 필요
+	upgradedHasher := NewHasher(NewArgon2idHasher(Argon2idParams{Memory: 2048, Iterations: 1, Parallelism: 1}))
+	require.NoError(t, upgradedHasher.CheckPassword(password, argon2idHash))
+	require.True(t, upgradedHasher.NeedsRehash(argon2idHash))
+
+	// bcrypt cost가 바뀐 경우 재암호화 필요
+	bcryptHasher := NewHasher(NewBcryptHasher(bcrypt.MinCost + 1))
+	require.True(t, bcryptHasher.NeedsRehash(bcryptHash))
+	require.True(t, bcryptHasher.NeedsRehash(argon2idHash))
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        util.Config
+		checkResponse func(hasher PasswordHasher, err error)
+	}{
+		{
+			name:   "기본값",
+			config: util.Config{Argon2Memory: 1024, Argon2Iterations: 1, Argon2Parallelism: 1},
+			checkResponse: func(hasher PasswordHasher, err error) {
+				require.NoError(t, err)
+				hashedPassword, err := hasher.HashPassword(util.CreateRandomString(10))
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(hashedPassword, "$argon2id$"))
+			},
+		},
+		{
+			name:   "bcrypt",
+			config: util.Config{PasswordHashAlgorithm: AlgorithmBcrypt, BcryptCost: bcrypt.MinCost},
+			checkResponse: func(hasher PasswordHasher, err error) {
+				require.NoError(t, err)
+				hashedPassword, err := hasher.HashPassword(util.CreateRandomString(10))
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(hashedPassword, "$2a$04$"))
+			},
+		},
+		{
+			name:   "지원하지 않는 알고리즘",
+			config: util.Config{PasswordHashAlgorithm: "md5"},
+			checkResponse: func(hasher PasswordHasher, err error) {
+				require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+				require.Nil(t, hasher)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			hasher, err := NewPasswordHasher(tc.config)
+			tc.checkResponse(hasher, err)
+		})
+	}
+}
+
+func TestDecodeArgon2idHash(t *testing.T) {
+	invalidHashes := []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$salt",
+		"$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=a,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+	}
+
+	for _, hashedPassword := range invalidHashes {
+		_, _, _, err := decodeArgon2idHash(hashedPassword)
+		require.ErrorIs(t, err, ErrInvalidHash, hashedPassword)
+	}
+}
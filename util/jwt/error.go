@@ -1,8 +0,0 @@
-package jwt
-
-import "fmt"
-
-var (
-	ErrInvalidToken = fmt.Errorf("token is invalid")
-	ErrExpiredToken = fmt.Errorf("token has expired")
-)
@@ -1,88 +0,0 @@
-package jwt
-
-import (
-	"errors"
-	"time"
-
-	"github.com/golang-jwt/jwt"
-	"github.com/google/uuid"
-)
-
-// 토큰 생성 함수
-func CreateToken(userID int64, secret string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(userID, duration)
-	if err != nil {
-		return "", payload, err
-	}
-
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
-
-	token, err := jwtToken.SignedString([]byte(secret))
-
-	return token, payload, err
-}
-
-// 토큰 검증 함수
-func VerifyToken(tokenString string, secret string) (*Payload, error) {
-	payload := &Payload{}
-	keyFunc := func(token *jwt.Token) (interface{}, error) {
-		_, ok := token.Method.(*jwt.SigningMethodHMAC)
-		if !ok {
-			return nil, ErrInvalidToken
-		}
-
-		return []byte(secret), nil
-	}
-
-	jwtToken, err := jwt.ParseWithClaims(tokenString, payload, keyFunc)
-	if err != nil {
-		verr, ok := err.(*jwt.ValidationError)
-		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
-			return nil, ErrExpiredToken
-		}
-
-		return nil, ErrInvalidToken
-	}
-
-	payload, ok := jwtToken.Claims.(*Payload)
-	if !ok {
-		return nil, ErrInvalidToken
-	}
-
-	return payload, nil
-}
-
-type Payload struct {
-	ID        string    `json:"id"`
-	UserID    int64     `json:"user_id"`
-	IssuedAt  time.Time `json:"issued_at"`
-	ExpiredAt time.Time `json:"expired_at"`
-}
-
-// payload 생성 함수
-func NewPayload(userID int64, duration time.Duration) (*Payload, error) {
-	tokenID, err := uuid.NewRandom()
-	if err != nil {
-		return nil, err
-	}
-
-	issuedAt := time.Now()
-
-	payload := &Payload{
-		ID:        tokenID.String(),
-		UserID:    userID,
-		IssuedAt:  issuedAt,
-		ExpiredAt: issuedAt.Add(duration),
-	}
-
-	return payload, nil
-}
-
-// payload 검증 함수
-func (payload *Payload) Valid() error {
-	if time.Now().After(payload.ExpiredAt) {
-		return ErrExpiredToken
-	}
-
-	return nil
-}
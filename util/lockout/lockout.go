@@ -0,0 +1,125 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// 키별 로그인 실패 기록
+type Record struct {
+	Failures     int
+	LastFailedAt time.Time
+	LockedUntil  time.Time
+	ExpiredAt    time.Time
+}
+
+// 실패 기록 저장소
+// 메모리 외의 저장소(DB, redis 등)로 교체 가능
+type Store interface {
+	Get(ctx context.Context, key string) (Record, error)
+	Update(ctx context.Context, key string, fn func(record Record) Record) (Record, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Policy struct {
+	// 잠금 전 허용 실패 횟수, 0 이하인 경우 제한하지 않음
+	MaxAttempts int
+	// 첫 잠금 시간, 이후 실패할 때마다 2배씩 증가
+	LockDuration time.Duration
+	// 최대 잠금 시간, 마지막 실패 후 이 시간이 지나면 실패 횟수 초기화
+	MaxLockDuration time.Duration
+}
+
+type Limiter struct {
+	store  Store
+	policy Policy
+	now    func() time.Time
+}
+
+func NewLimiter(store Store, policy Policy) *Limiter {
+	return &Limiter{
+		store:  store,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// 잠금 여부 조회 함수
+// 잠긴 경우 남은 잠금 시간 반환
+func (limiter *Limiter) Check(ctx context.Context, key string) (time.Duration, error) {
+	if limiter.policy.MaxAttempts <= 0 {
+		return 0, nil
+	}
+
+	record, err := limiter.store.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return limiter.retryAfter(record), nil
+}
+
+// 실패 기록 함수
+// 이번 실패로 잠긴 경우 잠금 시간 반환
+func (limiter *Limiter) Fail(ctx context.Context, key string) (time.Duration, error) {
+	if limiter.policy.MaxAttempts <= 0 {
+		return 0, nil
+	}
+
+	now := limiter.now()
+	record, err := limiter.store.Update(ctx, key, func(record Record) Record {
+		if now.Sub(record.LastFailedAt) > limiter.policy.MaxLockDuration {
+			record = Record{}
+		}
+
+		record.Failures++
+		record.LastFailedAt = now
+
+		if record.Failures >= limiter.policy.MaxAttempts {
+			record.LockedUntil = now.Add(limiter.lockDuration(record.Failures))
+		}
+
+		record.ExpiredAt = now.Add(limiter.policy.MaxLockDuration)
+		if record.LockedUntil.After(record.ExpiredAt) {
+			record.ExpiredAt = record.LockedUntil
+		}
+
+		return record
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return limiter.retryAfter(record), nil
+}
+
+// 실패 기록 초기화 함수
+func (limiter *Limiter) Reset(ctx context.Context, key string) error {
+	if limiter.policy.MaxAttempts <= 0 {
+		return nil
+	}
+
+	return limiter.store.Delete(ctx, key)
+}
+
+// 실패 횟수에 따른 잠금 시간 계산 함수
+func (limiter *Limiter) lockDuration(failures int) time.Duration {
+	duration := limiter.policy.LockDuration
+	for i := limiter.policy.MaxAttempts; i < failures; i++ {
+		duration *= 2
+		if duration >= limiter.policy.MaxLockDuration {
+			return limiter.policy.MaxLockDuration
+		}
+	}
+
+	return duration
+}
+
+func (limiter *Limiter) retryAfter(record Record) time.Duration {
+	retryAfter := record.LockedUntil.Sub(limiter.now())
+	if retryAfter < 0 {
+		return 0
+	}
+
+	return retryAfter
+}
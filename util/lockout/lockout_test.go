@@ -0,0 +1,99 @@
+package lockout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	key := util.CreateRandomPhoneNumber()
+
+	limiter := NewLimiter(NewMemoryStore(), Policy{
+		MaxAttempts:     3,
+		LockDuration:    time.Minute,
+		MaxLockDuration: 3 * time.Minute,
+	})
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	// 허용 횟수 전까지 잠기지 않음
+	for i := 0; i < 2; i++ {
+		retryAfter, err := limiter.Fail(ctx, key)
+		require.NoError(t, err)
+		require.Zero(t, retryAfter)
+	}
+
+	// 허용 횟수 도달 시 잠금
+	retryAfter, err := limiter.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, retryAfter, time.Minute)
+
+	retryAfter, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, retryAfter, time.Minute)
+
+	// 잠금 해제 후 실패 시 잠금 시간 2배
+	now = now.Add(time.Minute)
+	retryAfter, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	retryAfter, err = limiter.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, retryAfter, 2*time.Minute)
+
+	// 최대 잠금 시간 초과 불가
+	now = now.Add(2 * time.Minute)
+	retryAfter, err = limiter.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, retryAfter, 3*time.Minute)
+
+	// 초기화
+	err = limiter.Reset(ctx, key)
+	require.NoError(t, err)
+
+	retryAfter, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+}
+
+func TestLimiterWindow(t *testing.T) {
+	ctx := context.Background()
+	key := util.CreateRandomPhoneNumber()
+
+	limiter := NewLimiter(NewMemoryStore(), Policy{
+		MaxAttempts:     2,
+		LockDuration:    time.Minute,
+		MaxLockDuration: time.Hour,
+	})
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	retryAfter, err := limiter.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	// 마지막 실패 후 최대 잠금 시간이 지나면 실패 횟수 초기화
+	now = now.Add(time.Hour + time.Second)
+	retryAfter, err = limiter.Fail(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	ctx := context.Background()
+	key := util.CreateRandomPhoneNumber()
+
+	limiter := NewLimiter(NewMemoryStore(), Policy{})
+
+	for i := 0; i < 10; i++ {
+		retryAfter, err := limiter.Fail(ctx, key)
+		require.NoError(t, err)
+		require.Zero(t, retryAfter)
+	}
+}
@@ -0,0 +1,74 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+	sweptAt time.Time
+}
+
+// 메모리 저장소 생성 함수
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: map[string]Record{},
+		sweptAt: time.Now(),
+	}
+}
+
+func (store *MemoryStore) Get(ctx context.Context, key string) (Record, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	record, ok := store.records[key]
+	if !ok || time.Now().After(record.ExpiredAt) {
+		return Record{}, nil
+	}
+
+	return record, nil
+}
+
+func (store *MemoryStore) Update(ctx context.Context, key string, fn func(record Record) Record) (Record, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := time.Now()
+	store.sweep(now)
+
+	record, ok := store.records[key]
+	if !ok || now.After(record.ExpiredAt) {
+		record = Record{}
+	}
+
+	record = fn(record)
+	store.records[key] = record
+
+	return record, nil
+}
+
+func (store *MemoryStore) Delete(ctx context.Context, key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.records, key)
+
+	return nil
+}
+
+// 만료된 기록 삭제 함수 (1분에 한 번)
+func (store *MemoryStore) sweep(now time.Time) {
+	if now.Sub(store.sweptAt) < time.Minute {
+		return
+	}
+
+	for key, record := range store.records {
+		if now.After(record.ExpiredAt) {
+			delete(store.records, key)
+		}
+	}
+	store.sweptAt = now
+}
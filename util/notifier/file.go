@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// 테스트용 알림 발송기 (파일에 한 줄씩 추가)
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (notifier *FileNotifier) Notify(ctx context.Context, notification Notification) error {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	file, err := os.OpenFile(notifier.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%d\t%s\t%s\n", time.Now().Format(time.RFC3339), notification.UserID, notification.Title, notification.Message)
+	return err
+}
@@ -0,0 +1,20 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 개발용 알림 발송기 (로그로 출력)
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (notifier *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	log.Info().Int64("user_id", notification.UserID).Str("title", notification.Title).Str("message", notification.Message).Msg("notification sent")
+
+	return nil
+}
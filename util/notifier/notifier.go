@@ -0,0 +1,41 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gitaepark/pha/util"
+)
+
+const (
+	TypeLog  = "log"
+	TypeFile = "file"
+)
+
+var ErrUnsupportedNotifierType = fmt.Errorf("unsupported notifier type")
+
+// 회원 알림 내용
+type Notification struct {
+	UserID      int64
+	PhoneNumber string
+	Title       string
+	Message     string
+}
+
+// 회원 알림 발송기
+// 푸시, 이메일 등 실제 알림 채널 연동 시 이 인터페이스를 구현
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// config 기반 알림 발송기 생성 함수
+func NewNotifier(config util.Config) (Notifier, error) {
+	switch config.Notifier {
+	case "", TypeLog:
+		return NewLogNotifier(), nil
+	case TypeFile:
+		return NewFileNotifier(config.NotifierFilePath), nil
+	default:
+		return nil, ErrUnsupportedNotifierType
+	}
+}
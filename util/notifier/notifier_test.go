@@ -0,0 +1,74 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotifier(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        util.Config
+		checkResponse func(notifier Notifier, err error)
+	}{
+		{
+			name:   "기본값",
+			config: util.Config{},
+			checkResponse: func(notifier Notifier, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &LogNotifier{}, notifier)
+			},
+		},
+		{
+			name:   "파일",
+			config: util.Config{Notifier: TypeFile, NotifierFilePath: filepath.Join(t.TempDir(), "notification.log")},
+			checkResponse: func(notifier Notifier, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &FileNotifier{}, notifier)
+			},
+		},
+		{
+			name:   "지원하지 않는 종류",
+			config: util.Config{Notifier: "invalid"},
+			checkResponse: func(notifier Notifier, err error) {
+				require.ErrorIs(t, err, ErrUnsupportedNotifierType)
+				require.Nil(t, notifier)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			notifier, err := NewNotifier(tc.config)
+			tc.checkResponse(notifier, err)
+		})
+	}
+}
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notification.log")
+	notifier := NewFileNotifier(path)
+
+	userID1 := util.CreateRandomInt64(1, 10)
+	userID2 := util.CreateRandomInt64(11, 20)
+
+	require.NoError(t, notifier.Notify(context.Background(), Notification{UserID: userID1, Title: "title", Message: "first"}))
+	require.NoError(t, notifier.Notify(context.Background(), Notification{UserID: userID2, Title: "title", Message: "second"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasSuffix(lines[0], fmt.Sprintf("%d\ttitle\tfirst", userID1)))
+	require.True(t, strings.HasSuffix(lines[1], fmt.Sprintf("%d\ttitle\tsecond", userID2)))
+}
@@ -19,11 +19,8 @@ func init() {
 // 임의의 휴대폰 번호 생성 함수
 func CreateRandomPhoneNumber() string {
 	randomNumber := rand.Intn(1e8)
-	if randomNumber < 10000000 {
-		return fmt.Sprintf("0100%d", randomNumber)
-	} else {
-		return fmt.Sprintf("010%d", randomNumber)
-	}
+
+	return fmt.Sprintf("010%08d", randomNumber)
 }
 
 // 임의의 한글 문자열 생성 함수
@@ -41,6 +38,11 @@ func CreateRandomString(n int) string {
 	return string(result)
 }
 
+// 임의의 비밀번호 생성 함수 (소문자, 대문자, 숫자, 특수문자 포함)
+func CreateRandomPassword() string {
+	return fmt.Sprintf("Aa%d!%s", rand.Intn(10), CreateRandomString(8))
+}
+
 // 임의의 int32 생성 함수
 func CreateRandomInt32(min, max int32) int32 {
 	return min + rand.Int31n(max-min+1)
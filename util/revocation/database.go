@@ -0,0 +1,51 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gitaepark/pha/repository"
+)
+
+// DB 저장소에서 사용하는 쿼리
+type Querier interface {
+	CreateRevokedToken(ctx context.Context, arg repository.CreateRevokedTokenParams) error
+	GetRevokedToken(ctx context.Context, id string) (repository.RevokedToken, error)
+	PurgeRevokedTokens(ctx context.Context) (int64, error)
+}
+
+type DatabaseStore struct {
+	querier Querier
+}
+
+// DB 저장소 생성 함수
+func NewDatabaseStore(querier Querier) *DatabaseStore {
+	return &DatabaseStore{querier: querier}
+}
+
+func (store *DatabaseStore) Revoke(ctx context.Context, id string, expiredAt time.Time) error {
+	arg := repository.CreateRevokedTokenParams{
+		ID:        id,
+		ExpiredAt: expiredAt,
+	}
+
+	return store.querier.CreateRevokedToken(ctx, arg)
+}
+
+func (store *DatabaseStore) IsRevoked(ctx context.Context, id string) (bool, error) {
+	_, err := store.querier.GetRevokedToken(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (store *DatabaseStore) Purge(ctx context.Context) (int64, error) {
+	return store.querier.PurgeRevokedTokens(ctx)
+}
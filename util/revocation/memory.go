@@ -0,0 +1,78 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type MemoryStore struct {
+	mu      sync.Mutex
+	tokens  map[string]time.Time
+	sweptAt time.Time
+}
+
+// 메모리 저장소 생성 함수
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		tokens:  map[string]time.Time{},
+		sweptAt: time.Now(),
+	}
+}
+
+func (store *MemoryStore) Revoke(ctx context.Context, id string, expiredAt time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := time.Now()
+	store.sweep(now)
+
+	// 이미 더 늦게 만료되는 기록이 있는 경우 유지
+	if current, ok := store.tokens[id]; ok && current.After(expiredAt) {
+		return nil
+	}
+	store.tokens[id] = expiredAt
+
+	return nil
+}
+
+func (store *MemoryStore) IsRevoked(ctx context.Context, id string) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	expiredAt, ok := store.tokens[id]
+	if !ok || time.Now().After(expiredAt) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (store *MemoryStore) Purge(ctx context.Context) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.purge(time.Now()), nil
+}
+
+// 만료된 기록 삭제 함수 (1분에 한 번)
+func (store *MemoryStore) sweep(now time.Time) {
+	if now.Sub(store.sweptAt) < time.Minute {
+		return
+	}
+
+	store.purge(now)
+}
+
+func (store *MemoryStore) purge(now time.Time) int64 {
+	var count int64
+	for id, expiredAt := range store.tokens {
+		if now.After(expiredAt) {
+			delete(store.tokens, id)
+			count++
+		}
+	}
+	store.sweptAt = now
+
+	return count
+}
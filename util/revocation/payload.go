@@ -0,0 +1,22 @@
+package revocation
+
+import (
+	"context"
+
+	"github.com/gitaepark/pha/util/token"
+)
+
+// 토큰 폐기 여부 조회 함수
+// 토큰 자체 또는 토큰을 발급한 세션(refresh 토큰)이 폐기된 경우
+func IsPayloadRevoked(ctx context.Context, store Store, payload *token.Payload) (bool, error) {
+	revoked, err := store.IsRevoked(ctx, payload.ID)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	if payload.SessionID == "" {
+		return false, nil
+	}
+
+	return store.IsRevoked(ctx, payload.SessionID)
+}
@@ -0,0 +1,151 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/gitaepark/pha/util/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        util.Config
+		checkResponse func(store Store, err error)
+	}{
+		{
+			name:   "기본값",
+			config: util.Config{},
+			checkResponse: func(store Store, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &MemoryStore{}, store)
+			},
+		},
+		{
+			name:   "DB",
+			config: util.Config{TokenRevocationStore: TypeDatabase},
+			checkResponse: func(store Store, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &DatabaseStore{}, store)
+			},
+		},
+		{
+			name:   "지원하지 않는 종류",
+			config: util.Config{TokenRevocationStore: "invalid"},
+			checkResponse: func(store Store, err error) {
+				require.ErrorIs(t, err, ErrUnsupportedStoreType)
+				require.Nil(t, store)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store, err := NewStore(tc.config, nil)
+			tc.checkResponse(store, err)
+		})
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	id1 := uuid.NewString()
+	id2 := uuid.NewString()
+
+	// 폐기되지 않은 토큰
+	revoked, err := store.IsRevoked(ctx, id1)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// 폐기
+	err = store.Revoke(ctx, id1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	revoked, err = store.IsRevoked(ctx, id1)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// 만료 시각이 지난 기록은 폐기로 보지 않음
+	err = store.Revoke(ctx, id2, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	revoked, err = store.IsRevoked(ctx, id2)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// 더 이른 만료 시각으로 다시 폐기해도 기존 기록 유지
+	err = store.Revoke(ctx, id1, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	revoked, err = store.IsRevoked(ctx, id1)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// 만료된 기록 삭제
+	count, err := store.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestIsPayloadRevoked(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name    string
+		payload *token.Payload
+		revoke  func(store Store, payload *token.Payload)
+		revoked bool
+	}{
+		{
+			name:    "폐기되지 않은 토큰",
+			payload: &token.Payload{ID: uuid.NewString(), SessionID: uuid.NewString()},
+			revoke:  func(store Store, payload *token.Payload) {},
+			revoked: false,
+		},
+		{
+			name:    "토큰이 폐기된 경우",
+			payload: &token.Payload{ID: uuid.NewString(), SessionID: uuid.NewString()},
+			revoke: func(store Store, payload *token.Payload) {
+				require.NoError(t, store.Revoke(ctx, payload.ID, time.Now().Add(time.Minute)))
+			},
+			revoked: true,
+		},
+		{
+			name:    "세션이 폐기된 경우",
+			payload: &token.Payload{ID: uuid.NewString(), SessionID: uuid.NewString()},
+			revoke: func(store Store, payload *token.Payload) {
+				require.NoError(t, store.Revoke(ctx, payload.SessionID, time.Now().Add(time.Minute)))
+			},
+			revoked: true,
+		},
+		{
+			name:    "세션이 없는 토큰",
+			payload: &token.Payload{ID: uuid.NewString()},
+			revoke: func(store Store, payload *token.Payload) {
+				require.NoError(t, store.Revoke(ctx, "", time.Now().Add(time.Minute)))
+			},
+			revoked: false,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			tc.revoke(store, tc.payload)
+
+			revoked, err := IsPayloadRevoked(ctx, store, tc.payload)
+			require.NoError(t, err)
+			require.Equal(t, tc.revoked, revoked)
+		})
+	}
+}
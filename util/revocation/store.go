@@ -0,0 +1,38 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+)
+
+const (
+	TypeMemory   = "memory"
+	TypeDatabase = "database"
+)
+
+var ErrUnsupportedStoreType = fmt.Errorf("unsupported token revocation store type")
+
+// 폐기된 토큰 저장소 (토큰 ID 기준)
+// 토큰 만료 시각이 지나면 폐기 기록도 필요 없으므로 만료 시각까지만 보관
+type Store interface {
+	Revoke(ctx context.Context, id string, expiredAt time.Time) error
+	IsRevoked(ctx context.Context, id string) (bool, error)
+	// 만료된 폐기 기록 삭제
+	Purge(ctx context.Context) (int64, error)
+}
+
+// config 기반 폐기 토큰 저장소 생성 함수
+// 서버를 여러 대 운영하는 경우 database 사용
+func NewStore(config util.Config, querier Querier) (Store, error) {
+	switch config.TokenRevocationStore {
+	case "", TypeMemory:
+		return NewMemoryStore(), nil
+	case TypeDatabase:
+		return NewDatabaseStore(querier), nil
+	default:
+		return nil, ErrUnsupportedStoreType
+	}
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 주기적으로 실행할 작업
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// 등록된 작업을 각각의 주기로 실행하는 스케줄러
+type Scheduler struct {
+	mu      sync.Mutex
+	jobs    []job
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	started bool
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// 작업 등록 함수
+// 주기가 0 이하인 경우 등록하지 않음 (비활성화)
+func (scheduler *Scheduler) Register(name string, interval time.Duration, run JobFunc) {
+	if interval <= 0 {
+		log.Info().Str("job", name).Msg("scheduler job disabled")
+		return
+	}
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	if scheduler.started {
+		log.Error().Str("job", name).Msg("cannot register job after scheduler started")
+		return
+	}
+
+	scheduler.jobs = append(scheduler.jobs, job{
+		name:     name,
+		interval: interval,
+		run:      run,
+	})
+}
+
+// 스케줄러 시작 함수
+func (scheduler *Scheduler) Start() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	if scheduler.started {
+		return
+	}
+	scheduler.started = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler.cancel = cancel
+
+	for _, job := range scheduler.jobs {
+		scheduler.wg.Add(1)
+		go scheduler.runJob(ctx, job)
+	}
+}
+
+// 스케줄러 종료 함수
+// 실행 중인 작업이 끝날 때까지 대기하며, ctx가 먼저 끝나면 ctx의 에러 반환
+func (scheduler *Scheduler) Stop(ctx context.Context) error {
+	scheduler.mu.Lock()
+	if scheduler.cancel != nil {
+		scheduler.cancel()
+	}
+	scheduler.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// 작업 주기 실행 함수
+// 이전 실행이 끝나야 다음 실행을 시작하므로 같은 작업이 동시에 실행되지 않음
+func (scheduler *Scheduler) runJob(ctx context.Context, job job) {
+	defer scheduler.wg.Done()
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			start := time.Now()
+			err := job.run(ctx)
+			if err != nil {
+				log.Error().Err(err).Str("job", job.name).Msg("scheduler job failed")
+				continue
+			}
+
+			log.Debug().Str("job", job.name).Dur("duration", time.Since(start)).Msg("scheduler job finished")
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	scheduler := NewScheduler()
+
+	var count1, count2, disabled int32
+	scheduler.Register("job1", 10*time.Millisecond, func(ctx context.Context) error {
+		atomic.AddInt32(&count1, 1)
+		return nil
+	})
+	// 실패한 작업도 다음 주기에 다시 실행
+	scheduler.Register("job2", 10*time.Millisecond, func(ctx context.Context) error {
+		atomic.AddInt32(&count2, 1)
+		return errors.New("failed")
+	})
+	scheduler.Register("disabled", 0, func(ctx context.Context) error {
+		atomic.AddInt32(&disabled, 1)
+		return nil
+	})
+
+	scheduler.Start()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&count1) >= 2 && atomic.LoadInt32(&count2) >= 2
+	}, time.Second, 5*time.Millisecond)
+
+	err := scheduler.Stop(context.Background())
+	require.NoError(t, err)
+
+	// 종료 후에는 실행되지 않음
+	stopped := atomic.LoadInt32(&count1)
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, atomic.LoadInt32(&count1), stopped)
+	require.Zero(t, atomic.LoadInt32(&disabled))
+}
+
+func TestSchedulerStopWaitsForRunningJob(t *testing.T) {
+	scheduler := NewScheduler()
+
+	started := make(chan struct{})
+	var once sync.Once
+	var finished int32
+	scheduler.Register("slow", 10*time.Millisecond, func(ctx context.Context) error {
+		once.Do(func() { close(started) })
+		<-ctx.Done()
+		atomic.StoreInt32(&finished, 1)
+		return nil
+	})
+
+	scheduler.Start()
+	<-started
+
+	// 실행 중인 작업이 종료될 때까지 대기
+	err := scheduler.Stop(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, atomic.LoadInt32(&finished), int32(1))
+}
+
+func TestSchedulerStopTimeout(t *testing.T) {
+	scheduler := NewScheduler()
+
+	started := make(chan struct{})
+	var once sync.Once
+	release := make(chan struct{})
+	defer close(release)
+	scheduler.Register("stuck", 10*time.Millisecond, func(ctx context.Context) error {
+		once.Do(func() { close(started) })
+		<-release
+		return nil
+	})
+
+	scheduler.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := scheduler.Stop(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
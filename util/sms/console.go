@@ -0,0 +1,20 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 개발용 문자 발송기 (로그로 출력)
+type ConsoleSender struct{}
+
+func NewConsoleSender() *ConsoleSender {
+	return &ConsoleSender{}
+}
+
+func (sender *ConsoleSender) Send(ctx context.Context, phoneNumber string, message string) error {
+	log.Info().Str("phone_number", phoneNumber).Str("message", message).Msg("sms sent")
+
+	return nil
+}
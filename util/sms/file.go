@@ -0,0 +1,34 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// 테스트용 문자 발송기 (파일에 한 줄씩 추가)
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (sender *FileSender) Send(ctx context.Context, phoneNumber string, message string) error {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	file, err := os.OpenFile(sender.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phoneNumber, message)
+
+	return err
+}
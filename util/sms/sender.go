@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gitaepark/pha/util"
+)
+
+const (
+	TypeConsole = "console"
+	TypeFile    = "file"
+)
+
+var (
+	ErrUnsupportedSenderType   = fmt.Errorf("unsupported sms sender type")
+	ErrConsoleSenderNotAllowed = fmt.Errorf("console sms sender is only allowed in development")
+)
+
+// 문자 발송기
+// 실제 문자 발송 업체 연동 시 이 인터페이스를 구현
+type SMSSender interface {
+	Send(ctx context.Context, phoneNumber string, message string) error
+}
+
+// config 기반 문자 발송기 생성 함수
+// 설정이 없으면 인증번호가 로그에 남지 않도록 에러 반환
+func NewSMSSender(config util.Config) (SMSSender, error) {
+	switch config.SMSSender {
+	case TypeConsole:
+		// 콘솔 발송기는 인증번호를 로그에 평문으로 남기므로 개발 환경에서만 사용
+		if config.Environment != "development" {
+			return nil, ErrConsoleSenderNotAllowed
+		}
+		return NewConsoleSender(), nil
+	case TypeFile:
+		return NewFileSender(config.SMSFilePath), nil
+	default:
+		return nil, ErrUnsupportedSenderType
+	}
+}
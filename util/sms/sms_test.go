@@ -0,0 +1,89 @@
+package sms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSMSSender(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        util.Config
+		checkResponse func(sender SMSSender, err error)
+	}{
+		{
+			name:   "콘솔",
+			config: util.Config{Environment: "development", SMSSender: TypeConsole},
+			checkResponse: func(sender SMSSender, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &ConsoleSender{}, sender)
+			},
+		},
+		{
+			name:   "개발 환경이 아닌 경우 콘솔 사용 불가",
+			config: util.Config{Environment: "production", SMSSender: TypeConsole},
+			checkResponse: func(sender SMSSender, err error) {
+				require.ErrorIs(t, err, ErrConsoleSenderNotAllowed)
+				require.Nil(t, sender)
+			},
+		},
+		{
+			name:   "설정하지 않은 경우",
+			config: util.Config{},
+			checkResponse: func(sender SMSSender, err error) {
+				require.ErrorIs(t, err, ErrUnsupportedSenderType)
+				require.Nil(t, sender)
+			},
+		},
+		{
+			name:   "파일",
+			config: util.Config{SMSSender: TypeFile, SMSFilePath: filepath.Join(t.TempDir(), "sms.log")},
+			checkResponse: func(sender SMSSender, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &FileSender{}, sender)
+			},
+		},
+		{
+			name:   "지원하지 않는 종류",
+			config: util.Config{SMSSender: "invalid"},
+			checkResponse: func(sender SMSSender, err error) {
+				require.ErrorIs(t, err, ErrUnsupportedSenderType)
+				require.Nil(t, sender)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			sender, err := NewSMSSender(tc.config)
+			tc.checkResponse(sender, err)
+		})
+	}
+}
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.log")
+	sender := NewFileSender(path)
+
+	phoneNumber1 := util.CreateRandomPhoneNumber()
+	phoneNumber2 := util.CreateRandomPhoneNumber()
+
+	require.NoError(t, sender.Send(context.Background(), phoneNumber1, "first"))
+	require.NoError(t, sender.Send(context.Background(), phoneNumber2, "second"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasSuffix(lines[0], phoneNumber1+"\tfirst"))
+	require.True(t, strings.HasSuffix(lines[1], phoneNumber2+"\tsecond"))
+}
@@ -0,0 +1,16 @@
+package token
+
+import "fmt"
+
+var (
+	ErrInvalidToken = fmt.Errorf("token is invalid")
+	ErrExpiredToken = fmt.Errorf("token has expired")
+
+	ErrInvalidKey             = fmt.Errorf("key is invalid")
+	ErrDuplicateKeyID         = fmt.Errorf("duplicate key id")
+	ErrMismatchedKeyAlgorithm = fmt.Errorf("key does not match algorithm")
+	ErrUnsupportedAlgorithm   = fmt.Errorf("unsupported algorithm")
+	ErrInvalidKeySize         = fmt.Errorf("invalid key size: must be exactly 32 characters")
+	ErrInvalidSecretSize      = fmt.Errorf("invalid secret size: must be at least %d characters", minHMACSecretSize)
+	ErrUnsupportedTokenType   = fmt.Errorf("unsupported token type")
+)
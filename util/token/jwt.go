@@ -0,0 +1,102 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+type JWTMaker struct {
+	keySet *KeySet
+}
+
+// JWT 토큰 생성기 생성 함수
+func NewJWTMaker(keySet *KeySet) TokenMaker {
+	return &JWTMaker{
+		keySet: keySet,
+	}
+}
+
+// access 토큰 생성 함수
+func (maker *JWTMaker) CreateToken(userID int64, role string, sessionID string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(userID, role, sessionID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+// refresh 토큰 생성 함수
+func (maker *JWTMaker) CreateRefreshToken(userID int64, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewRefreshPayload(userID, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+// 용도 지정 토큰 생성 함수
+func (maker *JWTMaker) CreatePurposeToken(userID int64, purpose string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPurposePayload(userID, purpose, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+func (maker *JWTMaker) createToken(payload *Payload) (string, *Payload, error) {
+	signingKey := maker.keySet.signingKey
+	jwtToken := jwt.NewWithClaims(signingKey.Method, payload)
+	if signingKey.ID != "" {
+		jwtToken.Header["kid"] = signingKey.ID
+	}
+
+	token, err := jwtToken.SignedString(signingKey.signingKey)
+
+	return token, payload, err
+}
+
+// 토큰 검증 함수
+func (maker *JWTMaker) VerifyToken(tokenString string) (*Payload, error) {
+	payload := &Payload{}
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := maker.keySet.verifyKey(kid)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		// kid에 등록된 알고리즘과 다른 토큰 거부
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, ErrInvalidToken
+		}
+
+		return key.verifyKey, nil
+	}
+
+	jwtToken, err := jwt.ParseWithClaims(tokenString, payload, keyFunc)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
+			return nil, ErrExpiredToken
+		}
+
+		return nil, ErrInvalidToken
+	}
+
+	payload, ok := jwtToken.Claims.(*Payload)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return payload, nil
+}
+
+// 공개키 JWKS 조회 함수
+func (maker *JWTMaker) JWKS() JWKS {
+	return maker.keySet.JWKS()
+}
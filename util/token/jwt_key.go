@@ -0,0 +1,235 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// 서명/검증 키
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	signingKey interface{}
+	verifyKey  interface{}
+}
+
+// HMAC 키 생성 함수
+func NewHMACKey(id string, secret string) *Key {
+	return &Key{
+		ID:         id,
+		Method:     jwt.SigningMethodHS256,
+		signingKey: []byte(secret),
+		verifyKey:  []byte(secret),
+	}
+}
+
+// PEM 키 생성 함수
+// 개인키인 경우 서명과 검증에, 공개키인 경우 검증에만 사용
+func NewPEMKey(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	var parsedKey interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsedKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsedKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsedKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsedKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+
+	key := &Key{ID: id}
+	switch k := parsedKey.(type) {
+	case *rsa.PrivateKey:
+		key.Method = jwt.SigningMethodRS256
+		key.signingKey = k
+		key.verifyKey = &k.PublicKey
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+		key.verifyKey = k
+	case ed25519.PrivateKey:
+		key.Method = jwt.SigningMethodEdDSA
+		key.signingKey = k
+		key.verifyKey = k.Public()
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+		key.verifyKey = k
+	default:
+		return nil, ErrInvalidKey
+	}
+
+	return key, nil
+}
+
+// PEM 파일 키 조회 함수
+func LoadPEMKey(id string, path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPEMKey(id, data)
+}
+
+// 서명 키 1개와 검증 키 목록
+type KeySet struct {
+	signingKey *Key
+	keyMap     map[string]*Key
+	keyList    []*Key
+}
+
+// 키 목록 생성 함수
+func NewKeySet(signingKey *Key, verifyKeyList ...*Key) (*KeySet, error) {
+	if signingKey == nil || signingKey.signingKey == nil {
+		return nil, ErrInvalidKey
+	}
+
+	keySet := &KeySet{
+		signingKey: signingKey,
+		keyMap:     map[string]*Key{},
+	}
+
+	for _, key := range append([]*Key{signingKey}, verifyKeyList...) {
+		if _, ok := keySet.keyMap[key.ID]; ok {
+			return nil, ErrDuplicateKeyID
+		}
+
+		keySet.keyMap[key.ID] = key
+		keySet.keyList = append(keySet.keyList, key)
+	}
+
+	return keySet, nil
+}
+
+// HS256 서명 키 최소 길이
+const minHMACSecretSize = 32
+
+// config 기반 키 목록 조회 함수
+// JWT_PUBLIC_KEY_PATHS는 "kid=경로" 형식을 콤마로 구분
+func LoadKeySet(config util.Config) (*KeySet, error) {
+	var signingKey *Key
+	switch config.JWTAlgorithm {
+	case "", AlgorithmHS256:
+		// 빈 키나 짧은 키로 서명하면 토큰 위조 가능
+		if len(config.JWTSecret) < minHMACSecretSize {
+			return nil, ErrInvalidSecretSize
+		}
+		signingKey = NewHMACKey(config.JWTKeyID, config.JWTSecret)
+	case AlgorithmRS256, AlgorithmEdDSA:
+		key, err := LoadPEMKey(config.JWTKeyID, config.JWTPrivateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		if key.Method.Alg() != config.JWTAlgorithm {
+			return nil, ErrMismatchedKeyAlgorithm
+		}
+		signingKey = key
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	var verifyKeyList []*Key
+	for _, entry := range strings.Split(config.JWTPublicKeyPaths, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, path, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid public key entry: %s", entry)
+		}
+
+		key, err := LoadPEMKey(id, path)
+		if err != nil {
+			return nil, err
+		}
+		verifyKeyList = append(verifyKeyList, key)
+	}
+
+	return NewKeySet(signingKey, verifyKeyList...)
+}
+
+// 검증 키 조회 함수
+// kid 헤더가 없는 토큰은 현재 서명 키로 검증
+func (keySet *KeySet) verifyKey(id string) (*Key, bool) {
+	if id == "" {
+		return keySet.signingKey, true
+	}
+
+	key, ok := keySet.keyMap[id]
+	return key, ok
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// 공개키 JWKS 변환 함수
+// HMAC 키는 공개하지 않음
+func (keySet *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+
+	for _, key := range keySet.keyList {
+		switch k := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Kid: key.ID,
+				N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "OKP",
+				Use: "sig",
+				Alg: key.Method.Alg(),
+				Kid: key.ID,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(k),
+			})
+		}
+	}
+
+	return jwks
+}
@@ -0,0 +1,238 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsymmetricToken(t *testing.T) {
+	testCases := []struct {
+		name      string
+		algorithm string
+		newKey    func(t *testing.T) (privatePEM []byte, publicPEM []byte)
+	}{
+		{
+			name:      "RS256",
+			algorithm: AlgorithmRS256,
+			newKey:    createRandomRSAKey,
+		},
+		{
+			name:      "EdDSA",
+			algorithm: AlgorithmEdDSA,
+			newKey:    createRandomEd25519Key,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			userID := util.CreateRandomInt64(1, 10)
+			privatePEM, publicPEM := tc.newKey(t)
+
+			signingKey, err := NewPEMKey("key-1", privatePEM)
+			require.NoError(t, err)
+			require.Equal(t, signingKey.Method.Alg(), tc.algorithm)
+
+			keySet, err := NewKeySet(signingKey)
+			require.NoError(t, err)
+
+			token, payload1, err := NewJWTMaker(keySet).CreateToken(userID, "", "", time.Minute)
+			require.NoError(t, err)
+
+			jwtToken, _, err := new(jwt.Parser).ParseUnverified(token, &Payload{})
+			require.NoError(t, err)
+			require.Equal(t, jwtToken.Header["kid"], "key-1")
+			require.Equal(t, jwtToken.Header["alg"], tc.algorithm)
+
+			// 공개키만 가진 검증 측
+			verifyKey, err := NewPEMKey("key-1", publicPEM)
+			require.NoError(t, err)
+
+			_, err = NewKeySet(verifyKey)
+			require.ErrorIs(t, err, ErrInvalidKey)
+
+			otherKey, err := NewPEMKey("other", privatePEM)
+			require.NoError(t, err)
+			verifyKeySet, err := NewKeySet(otherKey, verifyKey)
+			require.NoError(t, err)
+
+			payload2, err := NewJWTMaker(verifyKeySet).VerifyToken(token)
+			require.NoError(t, err)
+			require.Equal(t, payload2.ID, payload1.ID)
+			require.Equal(t, payload2.UserID, payload1.UserID)
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+
+	oldPrivatePEM, oldPublicPEM := createRandomRSAKey(t)
+	oldKey, err := NewPEMKey("old", oldPrivatePEM)
+	require.NoError(t, err)
+	oldKeySet, err := NewKeySet(oldKey)
+	require.NoError(t, err)
+
+	oldToken, _, err := NewJWTMaker(oldKeySet).CreateToken(userID, "", "", time.Minute)
+	require.NoError(t, err)
+
+	newPrivatePEM, _ := createRandomEd25519Key(t)
+	newKey, err := NewPEMKey("new", newPrivatePEM)
+	require.NoError(t, err)
+
+	// 이전 키를 검증 키로 유지하면 기존 토큰 유효
+	oldVerifyKey, err := NewPEMKey("old", oldPublicPEM)
+	require.NoError(t, err)
+	rotatedKeySet, err := NewKeySet(newKey, oldVerifyKey)
+	require.NoError(t, err)
+
+	payload, err := NewJWTMaker(rotatedKeySet).VerifyToken(oldToken)
+	require.NoError(t, err)
+	require.Equal(t, payload.UserID, userID)
+
+	newToken, _, err := NewJWTMaker(rotatedKeySet).CreateToken(userID, "", "", time.Minute)
+	require.NoError(t, err)
+	_, err = NewJWTMaker(rotatedKeySet).VerifyToken(newToken)
+	require.NoError(t, err)
+
+	// 이전 키 제거 후 기존 토큰 거부
+	newKeySet, err := NewKeySet(newKey)
+	require.NoError(t, err)
+
+	_, err = NewJWTMaker(newKeySet).VerifyToken(oldToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = NewKeySet(newKey, oldVerifyKey, oldVerifyKey)
+	require.ErrorIs(t, err, ErrDuplicateKeyID)
+}
+
+func TestAlgorithmConfusion(t *testing.T) {
+	privatePEM, publicPEM := createRandomRSAKey(t)
+	signingKey, err := NewPEMKey("rsa", privatePEM)
+	require.NoError(t, err)
+	keySet, err := NewKeySet(signingKey)
+	require.NoError(t, err)
+
+	// 공개키를 HMAC secret으로 사용한 위조 토큰
+	payload, err := NewPayload(util.CreateRandomInt64(1, 10), "", "", time.Minute)
+	require.NoError(t, err)
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
+	jwtToken.Header["kid"] = "rsa"
+	token, err := jwtToken.SignedString(publicPEM)
+	require.NoError(t, err)
+
+	_, err = NewJWTMaker(keySet).VerifyToken(token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWKS(t *testing.T) {
+	rsaPrivatePEM, _ := createRandomRSAKey(t)
+	_, ed25519PublicPEM := createRandomEd25519Key(t)
+
+	rsaKey, err := NewPEMKey("rsa", rsaPrivatePEM)
+	require.NoError(t, err)
+	ed25519Key, err := NewPEMKey("ed25519", ed25519PublicPEM)
+	require.NoError(t, err)
+
+	keySet, err := NewKeySet(rsaKey, ed25519Key)
+	require.NoError(t, err)
+
+	jwks := keySet.JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	require.Equal(t, jwks.Keys[0].Kty, "RSA")
+	require.Equal(t, jwks.Keys[0].Alg, AlgorithmRS256)
+	require.Equal(t, jwks.Keys[0].Kid, "rsa")
+	require.Equal(t, jwks.Keys[0].E, "AQAB")
+	require.NotEmpty(t, jwks.Keys[0].N)
+
+	require.Equal(t, jwks.Keys[1].Kty, "OKP")
+	require.Equal(t, jwks.Keys[1].Alg, AlgorithmEdDSA)
+	require.Equal(t, jwks.Keys[1].Kid, "ed25519")
+	require.Equal(t, jwks.Keys[1].Crv, "Ed25519")
+	require.NotEmpty(t, jwks.Keys[1].X)
+
+	hmacKeySet, err := NewKeySet(NewHMACKey("", util.CreateRandomString(32)))
+	require.NoError(t, err)
+	require.Empty(t, hmacKeySet.JWKS().Keys)
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+
+	privatePEM, _ := createRandomEd25519Key(t)
+	_, oldPublicPEM := createRandomRSAKey(t)
+
+	privatePath := filepath.Join(dir, "private.pem")
+	oldPublicPath := filepath.Join(dir, "old.pub.pem")
+	require.NoError(t, os.WriteFile(privatePath, privatePEM, 0600))
+	require.NoError(t, os.WriteFile(oldPublicPath, oldPublicPEM, 0600))
+
+	config := util.Config{
+		JWTAlgorithm:      AlgorithmEdDSA,
+		JWTKeyID:          "new",
+		JWTPrivateKeyPath: privatePath,
+		JWTPublicKeyPaths: fmt.Sprintf("old=%s", oldPublicPath),
+	}
+
+	keySet, err := LoadKeySet(config)
+	require.NoError(t, err)
+	require.Len(t, keySet.JWKS().Keys, 2)
+
+	config.JWTAlgorithm = AlgorithmRS256
+	_, err = LoadKeySet(config)
+	require.ErrorIs(t, err, ErrMismatchedKeyAlgorithm)
+
+	config.JWTAlgorithm = "none"
+	_, err = LoadKeySet(config)
+	require.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	keySet, err = LoadKeySet(util.Config{JWTSecret: util.CreateRandomString(32)})
+	require.NoError(t, err)
+	require.Empty(t, keySet.JWKS().Keys)
+
+	_, err = LoadKeySet(util.Config{JWTAlgorithm: AlgorithmHS256})
+	require.ErrorIs(t, err, ErrInvalidSecretSize)
+
+	_, err = LoadKeySet(util.Config{JWTAlgorithm: AlgorithmHS256, JWTSecret: "1234567890123456789012345678901"})
+	require.ErrorIs(t, err, ErrInvalidSecretSize)
+}
+
+func createRandomRSAKey(t *testing.T) ([]byte, []byte) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return encodePEM(t, privateKey, &privateKey.PublicKey)
+}
+
+func createRandomEd25519Key(t *testing.T) ([]byte, []byte) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return encodePEM(t, privateKey, publicKey)
+}
+
+func encodePEM(t *testing.T, privateKey interface{}, publicKey interface{}) ([]byte, []byte) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	return privatePEM, publicPEM
+}
@@ -1,4 +1,4 @@
-package jwt
+package token
 
 import (
 	"testing"
@@ -8,11 +8,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestToken(t *testing.T) {
+func TestJWTMaker(t *testing.T) {
 	userID := util.CreateRandomInt64(1, 10)
-	secret := util.CreateRandomString(32)
+	keySet, err := NewKeySet(NewHMACKey("", util.CreateRandomString(32)))
+	require.NoError(t, err)
+	maker := NewJWTMaker(keySet)
 
-	token, payload1, err := CreateToken(userID, secret, time.Second)
+	token, payload1, err := maker.CreateToken(userID, "staff", "", time.Second)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload1)
@@ -20,17 +22,18 @@ func TestToken(t *testing.T) {
 	require.Equal(t, payload1.UserID, userID)
 	require.WithinDuration(t, payload1.ExpiredAt, time.Now(), time.Second)
 
-	payload2, err := VerifyToken(token, secret)
+	payload2, err := maker.VerifyToken(token)
 	require.NoError(t, err)
 	require.Equal(t, payload2.ID, payload1.ID)
 	require.Equal(t, payload2.UserID, payload1.UserID)
+	require.Equal(t, payload2.Role, "staff")
 	require.WithinDuration(t, payload2.ExpiredAt, payload1.ExpiredAt, time.Second)
 
-	_, err = VerifyToken(util.CreateRandomString(50), secret)
+	_, err = maker.VerifyToken(util.CreateRandomString(50))
 	require.ErrorIs(t, err, ErrInvalidToken)
 
 	time.Sleep(time.Second)
 
-	_, err = VerifyToken(token, secret)
+	_, err = maker.VerifyToken(token)
 	require.ErrorIs(t, err, ErrExpiredToken)
 }
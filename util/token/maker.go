@@ -0,0 +1,36 @@
+package token
+
+import (
+	"time"
+
+	"github.com/gitaepark/pha/util"
+)
+
+const (
+	TypeJWT    = "jwt"
+	TypePaseto = "paseto"
+)
+
+type TokenMaker interface {
+	CreateToken(userID int64, role string, sessionID string, duration time.Duration) (string, *Payload, error)
+	CreateRefreshToken(userID int64, role string, duration time.Duration) (string, *Payload, error)
+	CreatePurposeToken(userID int64, purpose string, duration time.Duration) (string, *Payload, error)
+	VerifyToken(token string) (*Payload, error)
+}
+
+// config 기반 토큰 생성기 생성 함수
+func NewTokenMaker(config util.Config) (TokenMaker, error) {
+	switch config.TokenType {
+	case "", TypeJWT:
+		keySet, err := LoadKeySet(config)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewJWTMaker(keySet), nil
+	case TypePaseto:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	default:
+		return nil, ErrUnsupportedTokenType
+	}
+}
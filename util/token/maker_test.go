@@ -0,0 +1,81 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTokenMaker(t *testing.T) {
+	maker, err := NewTokenMaker(util.Config{JWTSecret: util.CreateRandomString(32)})
+	require.NoError(t, err)
+	require.IsType(t, &JWTMaker{}, maker)
+
+	maker, err = NewTokenMaker(util.Config{TokenType: TypePaseto, TokenSymmetricKey: "12345678901234567890123456789012"})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	_, err = NewTokenMaker(util.Config{TokenType: util.CreateRandomString(5)})
+	require.ErrorIs(t, err, ErrUnsupportedTokenType)
+}
+
+func TestPurposeToken(t *testing.T) {
+	jwtMaker, err := NewTokenMaker(util.Config{JWTSecret: util.CreateRandomString(32)})
+	require.NoError(t, err)
+	pasetoMaker, err := NewTokenMaker(util.Config{TokenType: TypePaseto, TokenSymmetricKey: "12345678901234567890123456789012"})
+	require.NoError(t, err)
+
+	userID := util.CreateRandomInt64(1, 10)
+
+	for _, maker := range []TokenMaker{jwtMaker, pasetoMaker} {
+		token, payload1, err := maker.CreatePurposeToken(userID, PurposeTwoFactor, time.Minute)
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+
+		payload2, err := maker.VerifyToken(token)
+		require.NoError(t, err)
+		require.Equal(t, payload2.ID, payload1.ID)
+		require.Equal(t, payload2.UserID, userID)
+		require.Empty(t, payload2.SessionID)
+		require.Equal(t, payload2.Purpose, PurposeTwoFactor)
+
+		require.Empty(t, payload2.Kind)
+
+		// 일반 토큰은 용도가 비어있음
+		token, _, err = maker.CreateToken(userID, "", "", time.Minute)
+		require.NoError(t, err)
+		payload3, err := maker.VerifyToken(token)
+		require.NoError(t, err)
+		require.Empty(t, payload3.Purpose)
+	}
+}
+
+func TestTokenKind(t *testing.T) {
+	jwtMaker, err := NewTokenMaker(util.Config{JWTSecret: util.CreateRandomString(32)})
+	require.NoError(t, err)
+	pasetoMaker, err := NewTokenMaker(util.Config{TokenType: TypePaseto, TokenSymmetricKey: "12345678901234567890123456789012"})
+	require.NoError(t, err)
+
+	userID := util.CreateRandomInt64(1, 10)
+
+	for _, maker := range []TokenMaker{jwtMaker, pasetoMaker} {
+		refreshToken, refreshPayload, err := maker.CreateRefreshToken(userID, "staff", time.Minute)
+		require.NoError(t, err)
+
+		payload1, err := maker.VerifyToken(refreshToken)
+		require.NoError(t, err)
+		require.Equal(t, KindRefresh, payload1.Kind)
+		require.Equal(t, "staff", payload1.Role)
+		require.Empty(t, payload1.SessionID)
+
+		accessToken, _, err := maker.CreateToken(userID, "staff", refreshPayload.ID, time.Minute)
+		require.NoError(t, err)
+
+		payload2, err := maker.VerifyToken(accessToken)
+		require.NoError(t, err)
+		require.Equal(t, KindAccess, payload2.Kind)
+		require.Equal(t, refreshPayload.ID, payload2.SessionID)
+	}
+}
@@ -0,0 +1,223 @@
+package token
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/chacha20"
+)
+
+// PASETO v4.local (https://github.com/paseto-standard/paseto-spec/blob/master/docs/01-Protocol-Versions/Version4.md)
+const (
+	pasetoHeader      = "v4.local."
+	pasetoNonceSize   = 32
+	pasetoTagSize     = 32
+	pasetoEncKeyInfo  = "paseto-encryption-key"
+	pasetoAuthKeyInfo = "paseto-auth-key-for-aead"
+)
+
+type PasetoMaker struct {
+	symmetricKey []byte
+}
+
+// PASETO 토큰 생성기 생성 함수
+func NewPasetoMaker(symmetricKey string) (TokenMaker, error) {
+	if len(symmetricKey) != chacha20.KeySize {
+		return nil, ErrInvalidKeySize
+	}
+
+	return &PasetoMaker{
+		symmetricKey: []byte(symmetricKey),
+	}, nil
+}
+
+// access 토큰 생성 함수
+func (maker *PasetoMaker) CreateToken(userID int64, role string, sessionID string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(userID, role, sessionID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+// refresh 토큰 생성 함수
+func (maker *PasetoMaker) CreateRefreshToken(userID int64, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewRefreshPayload(userID, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+// 용도 지정 토큰 생성 함수
+func (maker *PasetoMaker) CreatePurposeToken(userID int64, purpose string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPurposePayload(userID, purpose, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return maker.createToken(payload)
+}
+
+func (maker *PasetoMaker) createToken(payload *Payload) (string, *Payload, error) {
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	nonce := make([]byte, pasetoNonceSize)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.encrypt(message, nonce)
+
+	return token, payload, err
+}
+
+// 토큰 검증 함수
+func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
+	message, err := maker.decrypt(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	err = json.Unmarshal(message, payload)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// 암호화 함수
+func (maker *PasetoMaker) encrypt(message []byte, nonce []byte) (string, error) {
+	encKey, encNonce, authKey, err := maker.splitKey(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	cipher, err := chacha20.NewUnauthenticatedCipher(encKey, encNonce)
+	if err != nil {
+		return "", err
+	}
+	cipherText := make([]byte, len(message))
+	cipher.XORKeyStream(cipherText, message)
+
+	tag, err := pasetoTag(authKey, nonce, cipherText)
+	if err != nil {
+		return "", err
+	}
+
+	body := make([]byte, 0, len(nonce)+len(cipherText)+len(tag))
+	body = append(body, nonce...)
+	body = append(body, cipherText...)
+	body = append(body, tag...)
+
+	return pasetoHeader + base64.RawURLEncoding.EncodeToString(body), nil
+}
+
+// 복호화 함수
+// footer가 포함된 토큰은 지원하지 않음
+func (maker *PasetoMaker) decrypt(token string) ([]byte, error) {
+	if !strings.HasPrefix(token, pasetoHeader) {
+		return nil, ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, pasetoHeader))
+	if err != nil || len(body) < pasetoNonceSize+pasetoTagSize {
+		return nil, ErrInvalidToken
+	}
+
+	nonce := body[:pasetoNonceSize]
+	cipherText := body[pasetoNonceSize : len(body)-pasetoTagSize]
+	tag := body[len(body)-pasetoTagSize:]
+
+	encKey, encNonce, authKey, err := maker.splitKey(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedTag, err := pasetoTag(authKey, nonce, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(tag, expectedTag) {
+		return nil, ErrInvalidToken
+	}
+
+	cipher, err := chacha20.NewUnauthenticatedCipher(encKey, encNonce)
+	if err != nil {
+		return nil, err
+	}
+	message := make([]byte, len(cipherText))
+	cipher.XORKeyStream(message, cipherText)
+
+	return message, nil
+}
+
+// nonce별 암호화 키, XChaCha20 nonce, 인증 키 생성 함수
+func (maker *PasetoMaker) splitKey(nonce []byte) ([]byte, []byte, []byte, error) {
+	encHash, err := blake2b.New(chacha20.KeySize+chacha20.NonceSizeX, maker.symmetricKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	encHash.Write([]byte(pasetoEncKeyInfo))
+	encHash.Write(nonce)
+	tmp := encHash.Sum(nil)
+
+	authHash, err := blake2b.New256(maker.symmetricKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	authHash.Write([]byte(pasetoAuthKeyInfo))
+	authHash.Write(nonce)
+
+	return tmp[:chacha20.KeySize], tmp[chacha20.KeySize:], authHash.Sum(nil), nil
+}
+
+// 인증 태그 생성 함수
+func pasetoTag(authKey []byte, nonce []byte, cipherText []byte) ([]byte, error) {
+	tagHash, err := blake2b.New256(authKey)
+	if err != nil {
+		return nil, err
+	}
+	tagHash.Write(preAuthEncode([]byte(pasetoHeader), nonce, cipherText, nil, nil))
+
+	return tagHash.Sum(nil), nil
+}
+
+// PAE(Pre-Authentication Encoding) 함수
+func preAuthEncode(pieces ...[]byte) []byte {
+	buf := &bytes.Buffer{}
+
+	le64 := func(n int) {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(n)&^(1<<63))
+		buf.Write(b)
+	}
+
+	le64(len(pieces))
+	for _, piece := range pieces {
+		le64(len(piece))
+		buf.Write(piece)
+	}
+
+	return buf.Bytes()
+}
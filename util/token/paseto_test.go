@@ -0,0 +1,62 @@
+package token
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/gitaepark/pha/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasetoMaker(t *testing.T) {
+	userID := util.CreateRandomInt64(1, 10)
+	maker, err := NewPasetoMaker("12345678901234567890123456789012")
+	require.NoError(t, err)
+
+	token, payload1, err := maker.CreateToken(userID, "staff", "", time.Second)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload1)
+
+	payload2, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, payload2.ID, payload1.ID)
+	require.Equal(t, payload2.UserID, payload1.UserID)
+	require.Equal(t, payload2.Role, "staff")
+	require.WithinDuration(t, payload2.ExpiredAt, payload1.ExpiredAt, time.Second)
+
+	_, err = maker.VerifyToken(token[:len(token)-1] + "A")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	otherMaker, err := NewPasetoMaker("abcdefghijklmnopqrstuvwxyz012345")
+	require.NoError(t, err)
+	_, err = otherMaker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	time.Sleep(time.Second)
+
+	_, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrExpiredToken)
+
+	_, err = NewPasetoMaker(util.CreateRandomString(5))
+	require.ErrorIs(t, err, ErrInvalidKeySize)
+}
+
+// 공식 테스트 벡터 4-E-1
+func TestPasetoEncrypt(t *testing.T) {
+	key, err := hex.DecodeString("707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f")
+	require.NoError(t, err)
+	maker := &PasetoMaker{symmetricKey: key}
+
+	message := `{"data":"this is a secret message","exp":"2022-01-01T00:00:00+00:00"}`
+	expected := "v4.local.AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAr68PS4AXe7If_ZgesdkUMvSwscFlAl1pk5HC0e8kApeaqMfGo_7OpBnwJOAbY9V7WU6abu74MmcUE8YWAiaArVI8XJ5hOb_4v9RmDkneN0S92dx0OW4pgy7omxgf3S8c3LlQg"
+
+	token, err := maker.encrypt([]byte(message), make([]byte, pasetoNonceSize))
+	require.NoError(t, err)
+	require.Equal(t, token, expected)
+
+	decrypted, err := maker.decrypt(expected)
+	require.NoError(t, err)
+	require.Equal(t, string(decrypted), message)
+}
@@ -0,0 +1,90 @@
+package token
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 토큰 종류 (인증 미들웨어는 access 토큰만 허용)
+const (
+	KindAccess  = "access"
+	KindRefresh = "refresh"
+)
+
+// access, refresh 토큰 외 특정 용도로만 사용하는 토큰
+const (
+	// 2단계 인증 대기 토큰
+	PurposeTwoFactor = "two_factor"
+)
+
+type Payload struct {
+	ID        string `json:"id"`
+	UserID    int64  `json:"user_id"`
+	Role      string `json:"role,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	// 비어있지 않은 경우 access, refresh 토큰으로 사용 불가
+	Purpose string `json:"purpose,omitempty"`
+	// API 키로 인증한 경우에만 설정되며 Scopes 범위 내에서만 접근 가능
+	ApiKeyID  int64     `json:"api_key_id,omitempty"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// access 토큰 payload 생성 함수
+func NewPayload(userID int64, role string, sessionID string, duration time.Duration) (*Payload, error) {
+	tokenID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	issuedAt := time.Now()
+
+	payload := &Payload{
+		ID:        tokenID.String(),
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
+		Kind:      KindAccess,
+		IssuedAt:  issuedAt,
+		ExpiredAt: issuedAt.Add(duration),
+	}
+
+	return payload, nil
+}
+
+// refresh 토큰 payload 생성 함수
+func NewRefreshPayload(userID int64, role string, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(userID, role, "", duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Kind = KindRefresh
+
+	return payload, nil
+}
+
+// 용도 지정 payload 생성 함수
+func NewPurposePayload(userID int64, purpose string, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(userID, "", "", duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Kind = ""
+	payload.Purpose = purpose
+
+	return payload, nil
+}
+
+// payload 검증 함수
+func (payload *Payload) Valid() error {
+	if time.Now().After(payload.ExpiredAt) {
+		return ErrExpiredToken
+	}
+
+	return nil
+}
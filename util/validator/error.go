@@ -15,16 +15,30 @@ func ErrValidate(err validator.ValidationErrors, obj interface{}, tag string) (v
 	tagName := findTagName(e, tag, fieldList)
 
 	switch err[0].ActualTag() {
-	case "required":
+	case "required", "required_with", "required_without":
 		vErr = ErrRequired(tagName)
 	case "max":
 		vErr = ErrMax(tagName, err[0].Param())
+	case "gte":
+		vErr = ErrGte(tagName, err[0].Param())
+	case "oneof":
+		vErr = ErrOneOf(tagName, err[0].Param())
 	case "phone_number":
 		vErr = ErrPhoneNumber(tagName)
+	case "verification_code":
+		vErr = ErrVerificationCode(tagName)
 	case "product_size":
 		vErr = ErrProductSize(tagName)
 	case "date":
 		vErr = ErrDate(tagName)
+	case passwordLengthTag:
+		vErr = ErrPasswordLength(tagName, err[0].Param())
+	case passwordCharClassesTag:
+		vErr = ErrPasswordCharClasses(tagName, err[0].Param())
+	case passwordPhoneNumberTag:
+		vErr = ErrPasswordPhoneNumber(tagName)
+	case "timezone":
+		vErr = ErrTimezone(tagName)
 	default:
 		vErr = err
 
@@ -41,10 +55,22 @@ func ErrMax(field string, param string) error {
 	return fmt.Errorf("%s's length should be smaller than or equals to %s", field, param)
 }
 
+func ErrGte(field string, param string) error {
+	return fmt.Errorf("%s should be greater than or equals to %s", field, param)
+}
+
+func ErrOneOf(field string, param string) error {
+	return fmt.Errorf("%s should be one of %s", field, param)
+}
+
 func ErrPhoneNumber(field string) error {
 	return fmt.Errorf("%s should be phone number format", field)
 }
 
+func ErrVerificationCode(field string) error {
+	return fmt.Errorf("%s should be 6 digits", field)
+}
+
 func ErrProductSize(field string) error {
 	return fmt.Errorf("%s should be small or large", field)
 }
@@ -53,6 +79,22 @@ func ErrDate(field string) error {
 	return fmt.Errorf("%s should be 0000-00-00 format", field)
 }
 
+func ErrTimezone(field string) error {
+	return fmt.Errorf("%s should be IANA time zone name", field)
+}
+
+func ErrPasswordLength(field string, minLength string) error {
+	return fmt.Errorf("%s should be at least %s characters", field, minLength)
+}
+
+func ErrPasswordCharClasses(field string, minCharClasses string) error {
+	return fmt.Errorf("%s should contain %s of lowercase, uppercase, number and special characters", field, minCharClasses)
+}
+
+func ErrPasswordPhoneNumber(field string) error {
+	return fmt.Errorf("%s should not contain phone number", field)
+}
+
 func getErrFieldList(err validator.ValidationErrors) []string {
 	reg := regexp.MustCompile(`\[[0-9]*\]`)
 	return strings.Split(reg.ReplaceAllString(err[0].Namespace(), ""), ".")[1:]
@@ -1,7 +1,13 @@
 package validator
 
 import (
+	"fmt"
+	"reflect"
 	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -9,6 +15,8 @@ import (
 const (
 	PHONE_NUMBER_REGEX = `^010([0-9]{4})([0-9]{4})$`
 
+	VERIFICATION_CODE_REGEX = `^[0-9]{6}$`
+
 	DATE_REGEX = `^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])$`
 
 	Small = "small"
@@ -26,6 +34,14 @@ var ValidatePhoneNumber validator.Func = func(fl validator.FieldLevel) bool {
 	return true
 }
 
+// validator 인증번호 양식(6자리 숫자) 검증 함수
+var ValidateVerificationCode validator.Func = func(fl validator.FieldLevel) bool {
+	if value, ok := fl.Field().Interface().(string); ok {
+		return validateRegex(VERIFICATION_CODE_REGEX, value)
+	}
+	return true
+}
+
 // validator 날짜 양식(0000-00-00) 검증 함수
 var ValidateDate validator.Func = func(fl validator.FieldLevel) bool {
 	if value, ok := fl.Field().Interface().(string); ok {
@@ -59,3 +75,136 @@ func IsSupportedProductSize(size string) bool {
 
 	return false
 }
+
+// 비밀번호 정책
+type PasswordPolicy struct {
+	// 최소 길이
+	MinLength int
+	// 소문자, 대문자, 숫자, 특수문자 중 포함해야 하는 최소 종류 수
+	MinCharClasses int
+}
+
+// 비밀번호 정책 검증 에러 메시지에 사용하는 필드명
+const passwordField = "password"
+
+// 비밀번호 정책 규칙별 태그
+// password 태그는 규칙별 태그의 별칭으로 등록되어 실패한 규칙을 에러 메시지로 구분
+const (
+	passwordLengthTag      = "password_length"
+	passwordCharClassesTag = "password_char_classes"
+	passwordPhoneNumberTag = "password_phone_number"
+)
+
+// validator 비밀번호 정책 검증 함수 등록 함수
+// 규칙별 검증 함수는 정책을 클로저로 보관하고, 별칭 파라미터는 에러 메시지에만 사용
+// 같은 구조체에 PhoneNumber 필드가 있으면 휴대폰 번호 포함 여부도 검증
+func RegisterPasswordValidation(v *Validate, tag string, policy PasswordPolicy) error {
+	err := v.RegisterValidation(passwordLengthTag, func(fl validator.FieldLevel) bool {
+		value, ok := fl.Field().Interface().(string)
+		return ok && policy.validateLength(value) == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = v.RegisterValidation(passwordCharClassesTag, func(fl validator.FieldLevel) bool {
+		value, ok := fl.Field().Interface().(string)
+		return ok && policy.validateCharClasses(value) == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = v.RegisterValidation(passwordPhoneNumberTag, func(fl validator.FieldLevel) bool {
+		value, ok := fl.Field().Interface().(string)
+		return ok && validatePasswordPhoneNumber(value, parentPhoneNumber(fl)) == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	v.RegisterAlias(tag, fmt.Sprintf("%s=%d,%s=%d,%s", passwordLengthTag, policy.MinLength, passwordCharClassesTag, policy.MinCharClasses, passwordPhoneNumberTag))
+
+	return nil
+}
+
+// 같은 구조체의 PhoneNumber 필드 값 조회 함수
+func parentPhoneNumber(fl validator.FieldLevel) string {
+	parent := fl.Parent()
+	if parent.Kind() == reflect.Ptr {
+		parent = parent.Elem()
+	}
+	if parent.Kind() == reflect.Struct {
+		if field := parent.FieldByName("PhoneNumber"); field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+
+	return ""
+}
+
+// 비밀번호 정책 검증 함수
+func (policy PasswordPolicy) Validate(password string, phoneNumber string) error {
+	if err := policy.validateLength(password); err != nil {
+		return err
+	}
+
+	if err := policy.validateCharClasses(password); err != nil {
+		return err
+	}
+
+	return validatePasswordPhoneNumber(password, phoneNumber)
+}
+
+// 비밀번호 최소 길이 검증 함수
+func (policy PasswordPolicy) validateLength(password string) error {
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		return ErrPasswordLength(passwordField, strconv.Itoa(policy.MinLength))
+	}
+
+	return nil
+}
+
+// 비밀번호 최소 문자 종류 수 검증 함수
+func (policy PasswordPolicy) validateCharClasses(password string) error {
+	if countCharClasses(password) < policy.MinCharClasses {
+		return ErrPasswordCharClasses(passwordField, strconv.Itoa(policy.MinCharClasses))
+	}
+
+	return nil
+}
+
+// 비밀번호 휴대폰 번호 포함 여부 검증 함수
+func validatePasswordPhoneNumber(password string, phoneNumber string) error {
+	if phoneNumber != "" && strings.Contains(password, phoneNumber) {
+		return ErrPasswordPhoneNumber(passwordField)
+	}
+
+	return nil
+}
+
+// 비밀번호 문자 종류(소문자, 대문자, 숫자, 특수문자) 수 계산 함수
+func countCharClasses(password string) int {
+	var lower, upper, digit, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			special = true
+		}
+	}
+
+	count := 0
+	for _, ok := range []bool{lower, upper, digit, special} {
+		if ok {
+			count++
+		}
+	}
+
+	return count
+}
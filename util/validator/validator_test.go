@@ -3,6 +3,7 @@ package validator
 import (
 	"testing"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,3 +18,62 @@ func TestIsSupportedProductSize(t *testing.T) {
 	require.True(t, IsSupportedProductSize("small"))
 	require.False(t, IsSupportedProductSize("medium"))
 }
+
+func TestPasswordPolicy(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 8, MinCharClasses: 3}
+
+	require.NoError(t, policy.Validate("Passw0rd", "01011112222"))
+	require.NoError(t, policy.Validate("pass!w0rd", ""))
+	require.Equal(t, policy.Validate("Pa0!", ""), ErrPasswordLength("password", "8"))
+	require.Equal(t, policy.Validate("password1", ""), ErrPasswordCharClasses("password", "3"))
+	require.Equal(t, policy.Validate("Pw01011112222", "01011112222"), ErrPasswordPhoneNumber("password"))
+	require.NoError(t, policy.Validate("Pw01011112222", ""))
+}
+
+func TestRegisterPasswordValidation(t *testing.T) {
+	type request struct {
+		PhoneNumber string `json:"phone_number"`
+		Password    string `json:"password" validate:"password"`
+	}
+
+	v := validator.New()
+	err := RegisterPasswordValidation(v, "password", PasswordPolicy{MinLength: 8, MinCharClasses: 3})
+	require.NoError(t, err)
+
+	require.NoError(t, v.Struct(&request{PhoneNumber: "01011112222", Password: "Passw0rd"}))
+
+	testCases := []struct {
+		name     string
+		request  request
+		expected error
+	}{
+		{
+			name:     "최소 길이 미달",
+			request:  request{Password: "Pa0!"},
+			expected: ErrPasswordLength("password", "8"),
+		},
+		{
+			name:     "문자 종류 수 부족",
+			request:  request{Password: "password1"},
+			expected: ErrPasswordCharClasses("password", "3"),
+		},
+		{
+			name:     "휴대폰 번호 포함",
+			request:  request{PhoneNumber: "01011112222", Password: "Pw01011112222"},
+			expected: ErrPasswordPhoneNumber("password"),
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := v.Struct(&tc.request)
+			require.Error(t, err)
+
+			vErrs, ok := err.(ValidationErrors)
+			require.True(t, ok)
+			require.Equal(t, tc.expected, ErrValidate(vErrs, &tc.request, "json"))
+		})
+	}
+}